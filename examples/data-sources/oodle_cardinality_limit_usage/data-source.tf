@@ -0,0 +1,20 @@
+data "oodle_cardinality_limit_usage" "requests_per_service" {
+  id = oodle_cardinality_limit.requests_per_service.id
+}
+
+output "services_near_limit" {
+  value = [
+    for u in data.oodle_cardinality_limit_usage.requests_per_service.usage :
+    u.label_value if u.usage_percent >= 80
+  ]
+}
+
+# Without id, the usage of every cardinality limit is read into limits.
+data "oodle_cardinality_limit_usage" "all" {}
+
+output "limits_near_max" {
+  value = [
+    for l in data.oodle_cardinality_limit_usage.all.limits :
+    l.name if anytrue([for u in l.usage : u.usage_percent >= 80])
+  ]
+}
@@ -0,0 +1,47 @@
+locals {
+  checkout_errors = {
+    labels = [
+      {
+        name = "step"
+        value_extractor = {
+          field = "msg"
+          regex = "step=(\\w+)"
+        }
+      }
+    ]
+    filter_query = "service:checkout AND level:(error OR fatal)"
+    metric_definitions = [
+      {
+        name = "oodle_logs_checkout_error_count"
+        type = "log_count"
+      },
+      {
+        name      = "oodle_logs_checkout_duration"
+        type      = "histogram"
+        field     = "log"
+        json_path = "duration"
+      }
+    ]
+  }
+}
+
+data "oodle_logmetrics_preview" "checkout_errors" {
+  definition = local.checkout_errors
+  samples = [
+    jsonencode({
+      service = "checkout"
+      level   = "error"
+      msg     = "step=payment failed"
+      log     = jsonencode({ duration = 1.25 })
+    }),
+    jsonencode({
+      service = "checkout"
+      level   = "info"
+      msg     = "step=payment done"
+    }),
+  ]
+}
+
+output "checkout_errors_preview" {
+  value = data.oodle_logmetrics_preview.checkout_errors.results
+}
@@ -0,0 +1,9 @@
+data "oodle_logmetrics_series" "checkout_errors" {
+  id = oodle_logmetrics.checkout_errors.id
+}
+
+output "checkout_errors_series" {
+  value = {
+    for m in data.oodle_logmetrics_series.checkout_errors.metrics : m.name => m.active_series
+  }
+}
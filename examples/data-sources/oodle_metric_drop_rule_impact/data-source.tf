@@ -0,0 +1,24 @@
+# Example: Review the impact of a selector before creating the rule
+data "oodle_metric_drop_rule_impact" "canary_requests" {
+  selector = "http_requests_total{env=\"dev\",pod=~\"canary-.*\"}"
+}
+
+output "canary_requests_impact" {
+  value = {
+    active_series      = data.oodle_metric_drop_rule_impact.canary_requests.active_series
+    samples_per_second = data.oodle_metric_drop_rule_impact.canary_requests.samples_per_second
+    top_metrics        = [for m in data.oodle_metric_drop_rule_impact.canary_requests.top_metrics : m.name]
+  }
+}
+
+# Example: Observe a rule in dry run mode before it drops anything
+resource "oodle_metric_drop_rule" "drop_go_gc" {
+  rule_name = "Drop unused go_gc metrics"
+  type      = "series"
+  selector  = "{__name__=~\"go_gc_.*\", job=\"unused-exporter\"}"
+  dry_run   = true
+}
+
+data "oodle_metric_drop_rule_impact" "drop_go_gc" {
+  rule_id = oodle_metric_drop_rule.drop_go_gc.id
+}
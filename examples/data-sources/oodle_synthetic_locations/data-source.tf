@@ -0,0 +1,13 @@
+data "oodle_synthetic_locations" "all" {}
+
+output "synthetic_locations" {
+  value = data.oodle_synthetic_locations.all.locations
+}
+
+# IDs of the managed AWS locations, e.g. to run a monitor from all of them.
+output "aws_synthetic_location_ids" {
+  value = [
+    for l in data.oodle_synthetic_locations.all.locations : l.id
+    if l.cloud == "aws"
+  ]
+}
@@ -0,0 +1,25 @@
+locals {
+  preview = provider::oodle::logmetrics_preview(
+    {
+      filter_query = "service:checkout AND level:error"
+      metric_definitions = [
+        {
+          name = "oodle_logs_checkout_error_count"
+          type = "log_count"
+        }
+      ]
+    },
+    [
+      jsonencode({ service = "checkout", level = "error" }),
+      jsonencode({ service = "checkout", level = "info" }),
+    ]
+  )
+}
+
+# Fail the plan if the rule does not match the sample error log.
+check "checkout_errors_match" {
+  assert {
+    condition     = local.preview[0].matched && !local.preview[1].matched
+    error_message = "The checkout error log metrics rule does not match the sample logs."
+  }
+}
@@ -14,8 +14,38 @@ provider "oodle" {
   deployment_url = "https://us1.oodle.ai/"
   instance       = "my-instance"
   api_key        = "my-api-key"
+
+  # Optional API keys for other instances that resources can target
+  # with their `instance` attribute.
+  instance_api_keys = {
+    "my-other-instance" = "my-other-api-key"
+  }
 }
 
+# Credentials can instead come from a profile in ~/.oodle/credentials:
+#
+#   [production]
+#   deployment_url     = https://us1.oodle.ai/
+#   instance           = my-instance
+#   credential_process = /usr/local/bin/oodle-credentials production
+#
+# provider "oodle" {
+#   profile = "production"
+# }
+
+# CI jobs can authenticate without long-lived keys by exchanging their OIDC
+# token for a short-lived access token:
+#
+# provider "oodle" {
+#   deployment_url = "https://us1.oodle.ai/"
+#   instance       = "my-instance"
+#   workload_identity = {
+#     token_url         = "https://auth.example.com/oauth2/token"
+#     audience          = "oodle"
+#     subject_token_env = "CI_JOB_JWT"
+#   }
+# }
+
 # Example usage of notifier, notification policy and monitor.
 # Refer to resource documentation on all configurable fields
 # of these resources.
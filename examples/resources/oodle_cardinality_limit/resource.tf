@@ -0,0 +1,35 @@
+# Example: Cap request metrics at 50k series per service
+resource "oodle_cardinality_limit" "requests_per_service" {
+  name = "Request metrics per service"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "=~"
+    value = "http_.*"
+  }
+
+  filters = [
+    {
+      name  = "env"
+      type  = "="
+      value = "prod"
+    }
+  ]
+
+  max_series = 50000
+  per_label  = "service"
+}
+
+# Example: Alert on kube-state-metrics growth without dropping series
+resource "oodle_cardinality_limit" "kube_state_metrics" {
+  name = "Kube state metrics"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "=~"
+    value = "kube_.*"
+  }
+
+  max_series = 200000
+  action     = "alert"
+}
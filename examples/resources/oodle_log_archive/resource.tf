@@ -0,0 +1,37 @@
+# Example: Archive audit logs to S3 for compliance
+resource "oodle_log_archive" "audit" {
+  name = "Audit archive"
+
+  filter = {
+    match = {
+      field    = "log_type"
+      operator = "is"
+      value    = "audit"
+    }
+  }
+
+  s3 = {
+    bucket   = "acme-log-archive"
+    prefix   = "audit/"
+    region   = "us-west-2"
+    role_arn = "arn:aws:iam::123456789012:role/oodle-log-archive"
+  }
+}
+
+# Example: Archive all logs to a MinIO bucket
+variable "minio_secret_access_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "oodle_log_archive" "minio" {
+  name = "MinIO archive"
+
+  s3 = {
+    bucket            = "logs"
+    endpoint          = "https://minio.example.com:9000"
+    force_path_style  = true
+    access_key_id     = "oodle-archiver"
+    secret_access_key = var.minio_secret_access_key
+  }
+}
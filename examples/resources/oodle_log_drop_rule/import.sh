@@ -0,0 +1,2 @@
+# Import an existing log drop rule using its UUID
+terraform import oodle_log_drop_rule.drop_healthchecks 123e4567-e89b-12d3-a456-426614174000
@@ -0,0 +1,32 @@
+# Example: Drop load balancer healthcheck logs
+resource "oodle_log_drop_rule" "drop_healthchecks" {
+  name = "Drop healthchecks"
+
+  filter = {
+    match = {
+      field    = "path"
+      operator = "is"
+      value    = "/healthz"
+    }
+  }
+
+  action = "drop"
+}
+
+# Example: Keep 10% of debug logs, sampled per trace so that the logs of a
+# kept trace are all kept
+resource "oodle_log_drop_rule" "sample_debug" {
+  name = "Sample debug logs"
+
+  filter = {
+    match = {
+      field    = "level"
+      operator = "is"
+      value    = "debug"
+    }
+  }
+
+  action            = "sample"
+  sample_percentage = 10
+  sample_key        = "trace_id"
+}
@@ -0,0 +1,58 @@
+resource "oodle_log_pipeline" "checkout" {
+  name = "tf_checkout"
+
+  processors = [
+    {
+      name = "parse access logs"
+      filter = {
+        match = {
+          field    = "service"
+          operator = "is"
+          value    = "nginx"
+        }
+      }
+      grok_parser = {
+        field    = "msg"
+        patterns = ["%%{IP:client} %%{WORD:method} %%{URIPATHPARAM:path} %%{NUMBER:status}"]
+      }
+    },
+    {
+      name = "parse checkout latency"
+      regex_parser = {
+        field = "msg"
+        regex = "took (?P<duration_ms>\\d+)ms"
+      }
+    },
+    {
+      json_parser = {
+        field        = "body"
+        target_field = "payload"
+      }
+    },
+    {
+      rename_field = {
+        field        = "lvl"
+        target_field = "level"
+      }
+    },
+    {
+      remove_field = {
+        fields = ["debug_context"]
+      }
+    },
+    {
+      add_attribute = {
+        field = "team"
+        value = "payments"
+      }
+    },
+    {
+      name = "mask emails"
+      redact = {
+        fields      = ["msg"]
+        regex       = "[\\w.+-]+@[\\w-]+\\.[\\w.]+"
+        replacement = "<email>"
+      }
+    },
+  ]
+}
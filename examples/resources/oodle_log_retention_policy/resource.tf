@@ -0,0 +1,38 @@
+# Example: Keep audit logs for a year
+resource "oodle_log_retention_policy" "audit" {
+  name     = "Audit logs"
+  priority = 0
+
+  filter = {
+    match = {
+      field    = "log_type"
+      operator = "is"
+      value    = "audit"
+    }
+  }
+
+  retention_days = 365
+}
+
+# Example: Keep debug logs for 3 days
+resource "oodle_log_retention_policy" "debug" {
+  name     = "Debug logs"
+  priority = 10
+
+  filter = {
+    match = {
+      field    = "level"
+      operator = "is"
+      value    = "debug"
+    }
+  }
+
+  retention_days = 3
+}
+
+# Example: Keep all other logs for 30 days
+resource "oodle_log_retention_policy" "default" {
+  name           = "Default"
+  priority       = 1000
+  retention_days = 30
+}
@@ -0,0 +1,2 @@
+# Import an existing log view using its UUID
+terraform import oodle_log_view.checkout_errors 123e4567-e89b-12d3-a456-426614174000
@@ -0,0 +1,23 @@
+resource "oodle_log_view" "checkout_errors" {
+  name         = "Checkout errors"
+  description  = "Errors of the checkout service"
+  filter_query = "service:checkout AND level:(error OR fatal)"
+  columns      = ["timestamp", "pod", "msg"]
+  time_range   = "1h"
+}
+
+# Link to the view from the runbook annotation of a monitor.
+resource "oodle_monitor" "checkout_errors" {
+  name         = "Checkout errors"
+  promql_query = "sum(rate(oodle_logs_checkout_error_count[5m]))"
+  conditions = {
+    critical = {
+      value     = 10
+      operation = ">"
+      for       = "5m"
+    }
+  }
+  annotations = {
+    runbook = "Check the ${oodle_log_view.checkout_errors.name} log view (ID ${oodle_log_view.checkout_errors.id})."
+  }
+}
@@ -90,6 +90,14 @@ resource "oodle_logmetrics" "coverage" {
       type      = "histogram"
       field     = "log",
       json_path = "duration"
+      unit      = "ms"
+      buckets = {
+        exponential = {
+          start  = 1
+          factor = 2
+          count  = 12
+        }
+      }
     },
     {
       name  = "oodle_logs_app_step_count"
@@ -99,3 +107,24 @@ resource "oodle_logmetrics" "coverage" {
     }
   ]
 }
+
+# Example of a resource that filters logs with a filter query
+resource "oodle_logmetrics" "checkout_errors" {
+  name         = "tf_checkout_errors"
+  filter_query = "service:checkout AND level:(error OR fatal) AND NOT msg:\"healthcheck\""
+  max_series   = 10000
+
+  metric_definitions = [
+    {
+      name = "oodle_logs_checkout_error_count"
+      type = "log_count"
+    },
+    {
+      name      = "oodle_logs_checkout_latency"
+      type      = "summary"
+      field     = "latency_ms"
+      unit      = "ms"
+      quantiles = [0.5, 0.9, 0.99]
+    }
+  ]
+}
@@ -0,0 +1,38 @@
+# Example: Merge request latency histograms per service and drop the raw series
+resource "oodle_metric_aggregation_rule" "latency_by_service" {
+  rule_name = "Request latency by service"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "="
+    value = "http_request_duration_seconds_bucket"
+  }
+
+  filters = [
+    {
+      name  = "cluster"
+      type  = "!="
+      value = "staging"
+    }
+  ]
+
+  keep_labels        = ["service", "le"]
+  aggregation        = "histogram_merge"
+  output_metric_name = "service:http_request_duration_seconds_bucket"
+  drop_raw_series    = true
+}
+
+# Example: Sum request counters without the per-pod labels
+resource "oodle_metric_aggregation_rule" "requests_without_pod" {
+  rule_name = "Requests without pod"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "="
+    value = "http_requests_total"
+  }
+
+  drop_labels        = ["pod", "instance"]
+  aggregation        = "sum"
+  output_metric_name = "job:http_requests_total:sum"
+}
@@ -29,3 +29,10 @@ resource "oodle_metric_drop_rule" "drop_specific_metric" {
     value = "kube_state_metrics_total"
   }
 }
+
+# Example: Drop canary series with a PromQL selector
+resource "oodle_metric_drop_rule" "drop_canary_requests" {
+  rule_name = "Drop dev canary requests"
+  type      = "series"
+  selector  = "http_requests_total{env=\"dev\",pod=~\"canary-.*\"}"
+}
@@ -0,0 +1,68 @@
+# Example: Rename the legacy request counter
+resource "oodle_metric_relabel_rule" "rename_requests" {
+  rule_name = "Rename legacy request counter"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "="
+    value = "legacy_http_requests"
+  }
+
+  source_labels = ["__name__"]
+  regex         = "legacy_(.*)"
+  replacement   = "$${1}_total"
+  target_label  = "__name__"
+}
+
+# Example: Add a shard label computed from the pod name
+resource "oodle_metric_relabel_rule" "shard_by_pod" {
+  rule_name = "Shard series by pod"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "=~"
+    value = "http_.*"
+  }
+
+  filters = [
+    {
+      name  = "env"
+      type  = "="
+      value = "prod"
+    }
+  ]
+
+  source_labels = ["namespace", "pod"]
+  modulus       = 8
+  target_label  = "shard"
+  action        = "hashmod"
+}
+
+# Example: Strip the version suffix from the service label
+resource "oodle_metric_relabel_rule" "service_without_version" {
+  rule_name = "Strip service versions"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "=~"
+    value = ".+"
+  }
+
+  source_labels = ["service"]
+  regex         = "(.+)-v[0-9]+"
+  target_label  = "service"
+}
+
+# Example: Remove build labels from Go runtime metrics
+resource "oodle_metric_relabel_rule" "drop_build_labels" {
+  rule_name = "Drop build labels"
+
+  metric_name = {
+    name  = "__name__"
+    type  = "=~"
+    value = "go_.*"
+  }
+
+  regex  = "build_.*"
+  action = "labeldrop"
+}
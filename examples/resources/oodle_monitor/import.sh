@@ -1,2 +1,5 @@
 # Import an existing monitor using its UUID
 terraform import oodle_monitor.service_monitor 123e4567-e89b-12d3-a456-426614174000
+
+# Import a monitor that belongs to another Oodle instance
+terraform import oodle_monitor.service_monitor my-other-instance/123e4567-e89b-12d3-a456-426614174000
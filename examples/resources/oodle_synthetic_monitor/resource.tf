@@ -99,15 +99,127 @@ resource "oodle_synthetic_monitor" "auth_flow" {
   }
 }
 
+# Example: TCP monitor that checks an SSH server answers with its banner
+resource "oodle_synthetic_monitor" "ssh" {
+  name      = "Git SSH"
+  enabled   = true
+  rule_type = "tcp"
+  interval  = "1m"
+  timeout   = "5s"
+
+  rule_config = {
+    tcp = {
+      host            = "git.example.com"
+      port            = 22
+      expected_banner = "SSH-2.0"
+    }
+  }
+}
+
+# Example: DNS monitor that checks a record resolves to the expected address
+resource "oodle_synthetic_monitor" "dns" {
+  name      = "example.com A record"
+  enabled   = true
+  rule_type = "dns"
+  interval  = "5m"
+  timeout   = "5s"
+
+  rule_config = {
+    dns = {
+      hostname         = "example.com"
+      record_type      = "A"
+      resolver         = "8.8.8.8"
+      expected_answers = ["93.184.216.34"]
+    }
+  }
+}
+
+# Example: TLS certificate monitor that fails two weeks before expiry
+resource "oodle_synthetic_monitor" "certificate" {
+  name      = "example.com certificate"
+  enabled   = true
+  rule_type = "ssl"
+  interval  = "1h"
+  timeout   = "10s"
+
+  rule_config = {
+    ssl = {
+      host                  = "example.com"
+      min_days_until_expiry = 14
+      expected_issuer       = "Let's Encrypt"
+      expected_sans         = ["example.com", "www.example.com"]
+    }
+  }
+}
+
+# Example: gRPC monitor that checks a service is serving, then makes a unary
+# call through server reflection
+resource "oodle_synthetic_monitor" "orders_grpc" {
+  name      = "Orders gRPC"
+  enabled   = true
+  rule_type = "grpc"
+  interval  = "1m"
+  timeout   = "10s"
+
+  rule_config = {
+    grpc = {
+      target  = "orders.example.com:443"
+      service = "orders.v1.OrderService"
+      tls     = true
+      metadata = {
+        "x-api-key" = "synthetic-key"
+      }
+
+      call = {
+        method          = "orders.v1.OrderService/GetOrder"
+        body            = jsonencode({ id = "synthetic-probe" })
+        expected_status = "NOT_FOUND"
+      }
+    }
+  }
+}
+
+# Example: HTTP monitor that runs from two managed regions and from a private
+# location, so that an internal endpoint is checked from inside the VPC too
+data "oodle_synthetic_locations" "all" {}
+
+resource "oodle_synthetic_private_location" "prod_vpc" {
+  name        = "Production VPC"
+  description = "Agent in the prod-us-east-1 VPC"
+}
+
+resource "oodle_synthetic_monitor" "internal_api" {
+  name      = "Internal API"
+  enabled   = true
+  rule_type = "http"
+  interval  = "1m"
+  timeout   = "10s"
+
+  locations = concat(
+    [
+      for l in data.oodle_synthetic_locations.all.locations : l.id
+      if l.cloud == "aws" && contains(["us-east-1", "eu-west-1"], l.region)
+    ],
+    [oodle_synthetic_private_location.prod_vpc.id],
+  )
+
+  rule_config = {
+    http = {
+      url                   = "https://internal-api.example.com/health"
+      method                = "GET"
+      expected_status_codes = ["2XX"]
+    }
+  }
+}
+
 # ---------------------------------------------------------------------------
 # Notifications for synthetic monitors
 #
-# A synthetic monitor only runs the check and emits the metric
-# `oodle_synthetic_monitor_up{monitor_id="<id>"}` (1 = up, 0 = down). It does
-# NOT send notifications on its own. To be alerted when a check fails, pair the
-# synthetic monitor with a companion `oodle_monitor` that watches this metric
-# and routes to your notifiers. This is the same mechanism the Oodle UI uses
-# under the hood when you enable notifications on a synthetic monitor.
+# The `alerting` block raises an alert when checks fail and routes it to your
+# notifiers. The alert is created and deleted together with the synthetic
+# monitor. Companion `oodle_monitor` resources that watch
+# `oodle_synthetic_monitor_up` keep working, but remove them when moving to
+# `alerting` to avoid duplicate notifications.
 # ---------------------------------------------------------------------------
 resource "oodle_synthetic_monitor" "checkout" {
   name      = "Checkout API"
@@ -115,6 +227,7 @@ resource "oodle_synthetic_monitor" "checkout" {
   rule_type = "http"
   interval  = "1m"
   timeout   = "10s"
+  locations = ["aws:us-east-1", "aws:eu-west-1", "aws:ap-southeast-1"]
 
   rule_config = {
     http = {
@@ -123,50 +236,22 @@ resource "oodle_synthetic_monitor" "checkout" {
       expected_status_codes = ["2XX"]
     }
   }
-}
 
-resource "oodle_monitor" "checkout_alert" {
-  name = "Synthetic Monitor: Checkout API"
-
-  # Fire when the synthetic monitor's most recent check is failing (up == 0).
-  # `monitor_id` is the synthetic monitor's ID, wired up via interpolation.
-  promql_query = "oodle_synthetic_monitor_up{monitor_id=\"${oodle_synthetic_monitor.checkout.id}\"} == 0"
-  interval     = "1m"
-
-  conditions = {
-    critical = {
-      operation = "=="
-      value     = 0
-      # Require the check to stay down for 5m before alerting (tune to taste).
-      for = "5m"
-    }
-  }
+  alerting = {
+    # Fire when 2 of the 3 locations fail, ignoring one-off blips at a single
+    # location.
+    failed_locations = 2
+    # A location counts as failed after 3 failed checks in a row, i.e. 3m.
+    consecutive_failures = 3
+    severity             = "critical"
 
-  # Link this alert to its synthetic monitor. `_oodle_synthetic_monitor_id`
-  # maps the companion alert to the synthetic monitor without reusing its ID,
-  # so the Oodle UI's Notifications toggle recognizes this Terraform-managed
-  # alert and it is hidden from the regular Monitors list. `source` keeps it
-  # consistent with the alerts the Oodle UI creates for synthetic monitors.
-  labels = {
-    source                      = "synthetic_monitor"
-    _oodle_synthetic_monitor_id = oodle_synthetic_monitor.checkout.id
-  }
-
-  # Send a single notification for the monitor rather than one per series.
-  grouping = {
-    by_monitor = true
-  }
-
-  annotations = {
-    summary = "Synthetic monitor Checkout API is down"
-  }
-
-  # Route failures to your notifiers (define these with oodle_notifier).
-  notifications = [
-    {
-      notifiers = {
-        any = [oodle_notifier.oncall.id]
+    # Route failures to your notifiers (define these with oodle_notifier).
+    notifications = [
+      {
+        notifiers = {
+          critical = [oodle_notifier.oncall.id]
+        }
       }
-    }
-  ]
+    ]
+  }
 }
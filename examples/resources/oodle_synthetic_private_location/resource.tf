@@ -0,0 +1,31 @@
+# A private location runs synthetic checks from an agent deployed in your own
+# network. The agent registers with the enrollment token, which is only
+# returned when the location is created.
+resource "oodle_synthetic_private_location" "prod_vpc" {
+  name        = "Production VPC"
+  description = "Agent in the prod-us-east-1 VPC"
+}
+
+# Store the token where the agent deployment can read it.
+resource "aws_secretsmanager_secret_version" "synthetics_agent_token" {
+  secret_id     = "synthetics-agent-enrollment-token"
+  secret_string = oodle_synthetic_private_location.prod_vpc.enrollment_token
+}
+
+# Run a monitor from the private location.
+resource "oodle_synthetic_monitor" "internal_api" {
+  name      = "Internal API"
+  enabled   = true
+  rule_type = "http"
+  interval  = "1m"
+  timeout   = "10s"
+  locations = [oodle_synthetic_private_location.prod_vpc.id]
+
+  rule_config = {
+    http = {
+      url                   = "https://internal-api.example.com/health"
+      method                = "GET"
+      expected_status_codes = ["2XX"]
+    }
+  }
+}
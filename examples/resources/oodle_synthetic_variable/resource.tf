@@ -0,0 +1,38 @@
+# A plain global variable, shared by every monitor that probes the API.
+resource "oodle_synthetic_variable" "api_host" {
+  name  = "API_HOST"
+  value = "api.example.com"
+}
+
+# A secret global variable. Its value is write-only: Oodle never returns it,
+# and it is redacted in check results and logs.
+resource "oodle_synthetic_variable" "api_token" {
+  name   = "API_TOKEN"
+  value  = var.synthetics_api_token
+  secret = true
+}
+
+variable "synthetics_api_token" {
+  type      = string
+  sensitive = true
+}
+
+# Monitors reference global variables with {{NAME}} placeholders. Using the
+# `reference` attribute instead of a literal placeholder makes the monitor
+# depend on the variable, so that the variable is created first.
+resource "oodle_synthetic_monitor" "orders" {
+  name      = "Orders API"
+  enabled   = true
+  rule_type = "http"
+  interval  = "1m"
+  timeout   = "10s"
+
+  rule_config = {
+    http = {
+      url                   = "https://${oodle_synthetic_variable.api_host.reference}/orders/health"
+      method                = "GET"
+      bearer_token          = oodle_synthetic_variable.api_token.reference
+      expected_status_codes = ["2XX"]
+    }
+  }
+}
@@ -0,0 +1,119 @@
+package logeval
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// MatchFilter reports whether the record matches the filter. A nil or empty
+// filter matches every record. When a filter sets more than one of match, all,
+// any and not, the record must match each of them.
+func (e *Evaluator) MatchFilter(filter *clientmodels.LogFilter, record Record) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+
+	if filter.Match != nil {
+		ok, err := e.match(filter.Match, record)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	if filter.MatchAll != nil {
+		for _, f := range filter.MatchAll.All {
+			ok, err := e.MatchFilter(f, record)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+	}
+	if filter.MatchAny != nil && len(filter.MatchAny.Any) > 0 {
+		matched := false
+		for _, f := range filter.MatchAny.Any {
+			ok, err := e.MatchFilter(f, record)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	if filter.MatchNot != nil && filter.MatchNot.Not != nil {
+		ok, err := e.MatchFilter(filter.MatchNot.Not, record)
+		if err != nil || ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (e *Evaluator) match(m *clientmodels.Match, record Record) (bool, error) {
+	value, ok, err := e.lookup(record, m.Field, m.JSONPath)
+	if err != nil {
+		return false, err
+	}
+
+	switch m.Operator {
+	case clientmodels.ExistsOperator:
+		return ok, nil
+	case clientmodels.IsOperator:
+		return ok && stringify(value) == m.Value, nil
+	case clientmodels.ContainsOperator:
+		return ok && strings.Contains(stringify(value), m.Value), nil
+	case clientmodels.MatchesRegexOperator:
+		re, err := e.regex(m.Value)
+		if err != nil {
+			return false, err
+		}
+		return ok && re.MatchString(stringify(value)), nil
+	default:
+		return false, fmt.Errorf("unknown operator %q for field %q", m.Operator, m.Field)
+	}
+}
+
+// CheckFilter compiles every regex and JSONPath in the filter, so that errors
+// are reported even when no record reaches them.
+func (e *Evaluator) CheckFilter(filter *clientmodels.LogFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if m := filter.Match; m != nil {
+		switch m.Operator {
+		case clientmodels.ExistsOperator, clientmodels.IsOperator, clientmodels.ContainsOperator:
+		case clientmodels.MatchesRegexOperator:
+			if err := e.checkRegex(m.Value); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown operator %q for field %q", m.Operator, m.Field)
+		}
+		if m.JSONPath != nil {
+			if _, err := e.jsonPath(*m.JSONPath); err != nil {
+				return err
+			}
+		}
+	}
+	var children []*clientmodels.LogFilter
+	if filter.MatchAll != nil {
+		children = append(children, filter.MatchAll.All...)
+	}
+	if filter.MatchAny != nil {
+		children = append(children, filter.MatchAny.Any...)
+	}
+	if filter.MatchNot != nil {
+		children = append(children, filter.MatchNot.Not)
+	}
+	for _, child := range children {
+		if err := e.CheckFilter(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package logeval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathStep is a single step of a JSONPath, either an object key or an array
+// index.
+type pathStep struct {
+	key   string
+	index int
+	isKey bool
+}
+
+// parseJSONPath parses the subset of JSONPath used by log metrics: an optional
+// leading "$" followed by ".key", "['key']" and "[index]" steps, e.g.
+// "$.items[0].id". A leading key may omit the dot, e.g. "service.id".
+func parseJSONPath(path string) ([]pathStep, error) {
+	rest := path
+	if strings.HasPrefix(rest, "$") {
+		rest = rest[1:]
+	} else if rest != "" && rest[0] != '.' && rest[0] != '[' {
+		// Paths like "service.id" start with a bare key.
+		rest = "." + rest
+	}
+
+	var steps []pathStep
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("invalid JSONPath %q: empty key", path)
+			}
+			if key == "*" {
+				return nil, fmt.Errorf("invalid JSONPath %q: wildcards are not supported", path)
+			}
+			steps = append(steps, pathStep{key: key, isKey: true})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: unclosed '['", path)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				steps = append(steps, pathStep{key: inner[1 : len(inner)-1], isKey: true})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: unsupported subscript [%v]", path, inner)
+			}
+			steps = append(steps, pathStep{index: index})
+		default:
+			return nil, fmt.Errorf("invalid JSONPath %q: unexpected %q", path, rest[0])
+		}
+	}
+	return steps, nil
+}
+
+// evalJSONPath returns the value at steps within v and whether it exists.
+func evalJSONPath(v any, steps []pathStep) (any, bool) {
+	for _, step := range steps {
+		if step.isKey {
+			obj, ok := v.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			v, ok = obj[step.key]
+			if !ok {
+				return nil, false
+			}
+			continue
+		}
+
+		arr, ok := v.([]any)
+		if !ok || step.index >= len(arr) {
+			return nil, false
+		}
+		v = arr[step.index]
+	}
+	return v, true
+}
@@ -0,0 +1,161 @@
+package logeval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// LogMetricsResult is the outcome of evaluating a log metrics definition
+// against a single record.
+type LogMetricsResult struct {
+	// Matched reports whether the record matched the filter.
+	Matched bool
+
+	// Labels are the label values attached to the metrics of a matched
+	// record. Labels whose extractor found no value are omitted.
+	Labels map[string]string
+
+	// Values are the values each metric definition emits for a matched
+	// record, keyed by metric name. Metrics that emit nothing are omitted,
+	// as are distinct_count metrics, whose value depends on other records.
+	Values map[string]float64
+
+	// Errors describe problems with this record, e.g. an extracted metric
+	// value that is not numeric.
+	Errors []string
+}
+
+// CheckLogMetrics validates the parts of a log metrics definition that can
+// fail during evaluation: operators, metric types, regexes and JSONPaths.
+func (e *Evaluator) CheckLogMetrics(rule *clientmodels.LogMetrics) error {
+	if err := e.CheckFilter(rule.Filter); err != nil {
+		return fmt.Errorf("filter: %v", err)
+	}
+
+	for _, label := range rule.Labels {
+		if label.ValueExtractor == nil {
+			continue
+		}
+		if err := e.checkExtractor(label.ValueExtractor.JSONPath, label.ValueExtractor.Regex); err != nil {
+			return fmt.Errorf("label %q: %v", label.Name, err)
+		}
+	}
+
+	for _, def := range rule.MetricDefinitions {
+		switch def.Type {
+		case clientmodels.LogCountMetricDefinition:
+		case clientmodels.CounterMetricDefinition,
+			clientmodels.GaugeMetricDefinition,
+			clientmodels.HistogramMetricDefinition,
+			clientmodels.SummaryMetricDefinition,
+			clientmodels.DistinctCountMetricDefinition:
+			if def.Field == "" {
+				return fmt.Errorf("metric %q: field is required for %v metrics", def.Name, def.Type)
+			}
+		default:
+			return fmt.Errorf("metric %q: unknown type %q", def.Name, def.Type)
+		}
+		if err := e.checkExtractor(def.JSONPath, def.Regex); err != nil {
+			return fmt.Errorf("metric %q: %v", def.Name, err)
+		}
+	}
+	return nil
+}
+
+func (e *Evaluator) checkExtractor(jsonPath *string, regex *string) error {
+	if jsonPath != nil {
+		if _, err := e.jsonPath(*jsonPath); err != nil {
+			return err
+		}
+	}
+	if regex != nil {
+		if err := e.checkRegex(*regex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EvaluateLogMetrics evaluates a log metrics definition against a record. The
+// definition should have been checked with CheckLogMetrics first. An
+// *UnsupportedRegexError is returned when the record reaches a regex that
+// cannot be evaluated locally.
+func (e *Evaluator) EvaluateLogMetrics(rule *clientmodels.LogMetrics, record Record) (LogMetricsResult, error) {
+	result := LogMetricsResult{
+		Labels: map[string]string{},
+		Values: map[string]float64{},
+	}
+
+	matched, err := e.MatchFilter(rule.Filter, record)
+	if err != nil {
+		return result, err
+	}
+	if !matched {
+		return result, nil
+	}
+	result.Matched = true
+
+	for _, label := range rule.Labels {
+		if label.Value != nil {
+			result.Labels[label.Name] = *label.Value
+			continue
+		}
+		if label.ValueExtractor == nil || label.ValueExtractor.Field == nil {
+			continue
+		}
+		extractor := label.ValueExtractor
+		value, ok, err := e.lookup(record, *extractor.Field, extractor.JSONPath)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			continue
+		}
+		s, ok, err := e.extract(stringify(value), extractor.Regex)
+		if err != nil {
+			return result, err
+		}
+		if ok {
+			result.Labels[label.Name] = s
+		}
+	}
+
+	for _, def := range rule.MetricDefinitions {
+		switch def.Type {
+		case clientmodels.LogCountMetricDefinition:
+			result.Values[def.Name] = 1
+			continue
+		case clientmodels.DistinctCountMetricDefinition:
+			continue
+		}
+
+		value, ok, err := e.lookup(record, def.Field, def.JSONPath)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			continue
+		}
+		if n, isNumber := value.(float64); isNumber && def.Regex == nil {
+			result.Values[def.Name] = n
+			continue
+		}
+		s, ok, err := e.extract(stringify(value), def.Regex)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("metric %q: value %q is not numeric", def.Name, s))
+			continue
+		}
+		result.Values[def.Name] = n
+	}
+	return result, nil
+}
@@ -0,0 +1,190 @@
+package logeval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/logfilter"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestEvaluateLogMetrics(t *testing.T) {
+	filter, err := logfilter.Parse(`service:checkout level:(error OR fatal) NOT msg:~healthcheck`)
+	assert.Nil(t, err)
+
+	rule := &clientmodels.LogMetrics{
+		Labels: []*clientmodels.Label{
+			{Name: "env", Value: ptr("prod")},
+			{Name: "user", ValueExtractor: &clientmodels.ValueExtractor{Field: ptr("log"), JSONPath: ptr("$.user.id")}},
+			{Name: "step", ValueExtractor: &clientmodels.ValueExtractor{Field: ptr("msg"), Regex: ptr(`step=(\w+)`)}},
+		},
+		Filter: filter,
+		MetricDefinitions: []*clientmodels.MetricDefinition{
+			{Name: "errors_total", Type: clientmodels.LogCountMetricDefinition},
+			{Name: "latency", Type: clientmodels.HistogramMetricDefinition, Field: "latency_ms"},
+			{Name: "items", Type: clientmodels.GaugeMetricDefinition, Field: "log", JSONPath: ptr("items[1]")},
+			{Name: "retries", Type: clientmodels.CounterMetricDefinition, Field: "msg", Regex: ptr(`retries=(\S+)`)},
+		},
+	}
+
+	evaluator := NewEvaluator()
+	assert.Nil(t, evaluator.CheckLogMetrics(rule))
+
+	tests := []struct {
+		name   string
+		record string
+		want   LogMetricsResult
+	}{
+		{
+			name:   "matched",
+			record: `{"service":"checkout","level":"error","msg":"step=pay retries=3","latency_ms":12.5,"log":"{\"user\":{\"id\":42},\"items\":[1,7]}"}`,
+			want: LogMetricsResult{
+				Matched: true,
+				Labels:  map[string]string{"env": "prod", "user": "42", "step": "pay"},
+				Values:  map[string]float64{"errors_total": 1, "latency": 12.5, "items": 7, "retries": 3},
+			},
+		},
+		{
+			name:   "missing values are omitted",
+			record: `{"service":"checkout","level":"fatal","msg":"retries=many"}`,
+			want: LogMetricsResult{
+				Matched: true,
+				Labels:  map[string]string{"env": "prod"},
+				Values:  map[string]float64{"errors_total": 1},
+				Errors:  []string{`metric "retries": value "many" is not numeric`},
+			},
+		},
+		{
+			name:   "excluded by not",
+			record: `{"service":"checkout","level":"error","msg":"healthcheck failed"}`,
+			want: LogMetricsResult{
+				Labels: map[string]string{},
+				Values: map[string]float64{},
+			},
+		},
+		{
+			name:   "level does not match",
+			record: `{"service":"checkout","level":"info"}`,
+			want: LogMetricsResult{
+				Labels: map[string]string{},
+				Values: map[string]float64{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := ParseRecord(tt.record)
+			assert.Nil(t, err)
+
+			got, err := evaluator.EvaluateLogMetrics(rule, record)
+			assert.Nil(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestMatchFilterOperators(t *testing.T) {
+	record, err := ParseRecord(`{"status":200,"ok":true,"path":"/api/v1/users","payload":{"tags":["a","b"]}}`)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{query: "status:200", want: true},
+		{query: "ok:true", want: true},
+		{query: "path:~/v1/", want: true},
+		{query: `path:/^\/api\/v[0-9]+\//`, want: true},
+		{query: "path:/^v1/", want: false},
+		{query: "payload:*", want: true},
+		{query: "missing:*", want: false},
+		{query: "payload[$.tags[1]]:b", want: true},
+		{query: "payload[$.tags[2]]:*", want: false},
+		{query: "payload[tags[0]]:a", want: true},
+	}
+
+	evaluator := NewEvaluator()
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			filter, err := logfilter.Parse(tt.query)
+			assert.Nil(t, err)
+
+			got, err := evaluator.MatchFilter(filter, record)
+			assert.Nil(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestCheckLogMetricsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *clientmodels.LogMetrics
+	}{
+		{
+			name: "invalid filter regex",
+			rule: &clientmodels.LogMetrics{Filter: &clientmodels.LogFilter{Match: &clientmodels.Match{
+				Field: "msg", Operator: clientmodels.MatchesRegexOperator, Value: "(unclosed",
+			}}},
+		},
+		{
+			name: "invalid label regex",
+			rule: &clientmodels.LogMetrics{Labels: []*clientmodels.Label{
+				{Name: "a", ValueExtractor: &clientmodels.ValueExtractor{Field: ptr("msg"), Regex: ptr("[")}},
+			}},
+		},
+		{
+			name: "unsupported json path",
+			rule: &clientmodels.LogMetrics{MetricDefinitions: []*clientmodels.MetricDefinition{
+				{Name: "a", Type: clientmodels.GaugeMetricDefinition, Field: "log", JSONPath: ptr("$.items[*]")},
+			}},
+		},
+		{
+			name: "missing field",
+			rule: &clientmodels.LogMetrics{MetricDefinitions: []*clientmodels.MetricDefinition{
+				{Name: "a", Type: clientmodels.CounterMetricDefinition},
+			}},
+		},
+		{
+			name: "unknown metric type",
+			rule: &clientmodels.LogMetrics{MetricDefinitions: []*clientmodels.MetricDefinition{
+				{Name: "a", Type: "count"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewEvaluator().CheckLogMetrics(tt.rule); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestUnsupportedRegex(t *testing.T) {
+	// The u flag is valid in the Rust dialect, but Go cannot compile it.
+	rule := &clientmodels.LogMetrics{
+		Filter: &clientmodels.LogFilter{Match: &clientmodels.Match{
+			Field: "msg", Operator: clientmodels.MatchesRegexOperator, Value: `(?u)^\w+ failed`,
+		}},
+		MetricDefinitions: []*clientmodels.MetricDefinition{
+			{Name: "errors_total", Type: clientmodels.LogCountMetricDefinition},
+		},
+	}
+
+	e := NewEvaluator()
+	assert.Nil(t, e.CheckLogMetrics(rule))
+
+	_, err := e.EvaluateLogMetrics(rule, Record{"msg": "payment failed"})
+	var unsupported *UnsupportedRegexError
+	assert.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, unsupported.Pattern, `(?u)^\w+ failed`)
+}
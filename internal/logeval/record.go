@@ -0,0 +1,162 @@
+// Package logeval evaluates log filters, value extractors and log metrics
+// definitions against sample log records without calling Oodle, so that rules
+// can be checked before they are deployed.
+package logeval
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"terraform-provider-oodle/internal/rustregex"
+)
+
+// Record is a structured log record, decoded from a JSON object.
+type Record map[string]any
+
+// ParseRecord decodes a JSON object into a Record.
+func ParseRecord(data string) (Record, error) {
+	var record Record
+	if err := jsoniter.UnmarshalFromString(data, &record); err != nil {
+		return nil, fmt.Errorf("log record is not a JSON object: %v", err)
+	}
+	if record == nil {
+		return nil, fmt.Errorf("log record is not a JSON object")
+	}
+	return record, nil
+}
+
+// Evaluator evaluates filters and extractors against records. It caches the
+// regexes and JSONPaths it compiles, so a single Evaluator should be reused for
+// all the records checked against a definition.
+type Evaluator struct {
+	regexes   map[string]*regexp.Regexp
+	jsonPaths map[string][]pathStep
+}
+
+// NewEvaluator returns an Evaluator with empty caches.
+func NewEvaluator() *Evaluator {
+	return &Evaluator{
+		regexes:   map[string]*regexp.Regexp{},
+		jsonPaths: map[string][]pathStep{},
+	}
+}
+
+// UnsupportedRegexError reports a regex that is valid in the Rust dialect that
+// Oodle evaluates, but that Go cannot compile, e.g. one using the u or x
+// flags. Oodle accepts and evaluates such regexes; only the local evaluation
+// is not possible.
+type UnsupportedRegexError struct {
+	Pattern string
+	Err     error
+}
+
+// Error implements error.
+func (e *UnsupportedRegexError) Error() string {
+	return fmt.Sprintf("regex %q is not supported by the preview: %v", e.Pattern, e.Err)
+}
+
+func (e *Evaluator) regex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := e.regexes[pattern]; ok {
+		return re, nil
+	}
+	if _, err := rustregex.Check(pattern); err != nil {
+		return nil, fmt.Errorf("invalid regex %q: %v", pattern, err)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, &UnsupportedRegexError{Pattern: pattern, Err: err}
+	}
+	e.regexes[pattern] = re
+	return re, nil
+}
+
+// checkRegex checks that a pattern is a valid Rust regex. Regexes that are
+// valid but cannot be evaluated locally are not reported, as only the records
+// that reach them are affected.
+func (e *Evaluator) checkRegex(pattern string) error {
+	_, err := e.regex(pattern)
+	var unsupported *UnsupportedRegexError
+	if errors.As(err, &unsupported) {
+		return nil
+	}
+	return err
+}
+
+func (e *Evaluator) jsonPath(path string) ([]pathStep, error) {
+	if steps, ok := e.jsonPaths[path]; ok {
+		return steps, nil
+	}
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	e.jsonPaths[path] = steps
+	return steps, nil
+}
+
+// lookup returns the value of field in the record, optionally narrowed down by
+// a JSONPath, and whether it exists. A JSONPath is applied to the decoded field
+// value when the field holds a JSON document as a string.
+func (e *Evaluator) lookup(record Record, field string, jsonPath *string) (any, bool, error) {
+	value, ok := record[field]
+	if !ok || jsonPath == nil {
+		return value, ok, nil
+	}
+
+	steps, err := e.jsonPath(*jsonPath)
+	if err != nil {
+		return nil, false, err
+	}
+	if s, isString := value.(string); isString {
+		if err := jsoniter.UnmarshalFromString(s, &value); err != nil {
+			return nil, false, nil
+		}
+	}
+	value, ok = evalJSONPath(value, steps)
+	return value, ok, nil
+}
+
+// extract applies an optional regex to a value. The first capture group is
+// returned when the regex has one, and the whole match otherwise.
+func (e *Evaluator) extract(value string, regex *string) (string, bool, error) {
+	if regex == nil {
+		return value, true, nil
+	}
+	re, err := e.regex(*regex)
+	if err != nil {
+		return "", false, err
+	}
+	match := re.FindStringSubmatch(value)
+	if match == nil {
+		return "", false, nil
+	}
+	if len(match) > 1 {
+		return match[1], true, nil
+	}
+	return match[0], true, nil
+}
+
+// stringify returns the string form of a decoded JSON value, as it is
+// compared by filters and used for label values.
+func stringify(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		s, err := jsoniter.MarshalToString(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return s
+	}
+}
@@ -0,0 +1,179 @@
+package logfilter
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// Format returns the canonical query for filter. Parsing the result yields
+// filter again, with nested filters of the same kind flattened.
+//
+// Filters that set more than one of Match, MatchAll, MatchAny and MatchNot
+// have no query representation and are reported as errors.
+func Format(filter *clientmodels.LogFilter) (string, error) {
+	var b strings.Builder
+	if err := format(&b, filter); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Canonicalize parses query and formats the result, so that equivalent
+// queries yield the same string.
+func Canonicalize(query string) (string, error) {
+	filter, err := Parse(query)
+	if err != nil {
+		return "", err
+	}
+	return Format(filter)
+}
+
+func format(b *strings.Builder, filter *clientmodels.LogFilter) error {
+	if filter == nil {
+		return fmt.Errorf("empty filter")
+	}
+
+	set := 0
+	for _, isSet := range []bool{
+		filter.Match != nil,
+		filter.MatchAll != nil,
+		filter.MatchAny != nil,
+		filter.MatchNot != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	switch set {
+	case 0:
+		return fmt.Errorf("empty filter")
+	case 1:
+	default:
+		return fmt.Errorf("filter sets more than one of match, all, any and not")
+	}
+
+	switch {
+	case filter.Match != nil:
+		return formatMatch(b, filter.Match)
+	case filter.MatchAll != nil:
+		return formatOperands(b, filter.MatchAll.All, andKeyword, func(f *clientmodels.LogFilter) bool {
+			return f != nil && f.MatchAny != nil
+		})
+	case filter.MatchAny != nil:
+		return formatOperands(b, filter.MatchAny.Any, orKeyword, func(f *clientmodels.LogFilter) bool {
+			return f != nil && f.MatchAll != nil
+		})
+	default:
+		b.WriteString(notKeyword + " ")
+		not := filter.MatchNot.Not
+		return formatOperand(b, not, not != nil && (not.MatchAll != nil || not.MatchAny != nil))
+	}
+}
+
+// formatOperands joins operands with op. Operands for which needsParens
+// returns true are parenthesized, even where precedence would not require
+// it, to keep mixed expressions readable.
+func formatOperands(
+	b *strings.Builder,
+	operands []*clientmodels.LogFilter,
+	op string,
+	needsParens func(*clientmodels.LogFilter) bool,
+) error {
+	if len(operands) == 0 {
+		return fmt.Errorf("empty %v", strings.ToLower(op))
+	}
+
+	for i, operand := range operands {
+		if i > 0 {
+			b.WriteString(" " + op + " ")
+		}
+		if err := formatOperand(b, operand, needsParens(operand)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatOperand(b *strings.Builder, filter *clientmodels.LogFilter, parens bool) error {
+	if parens {
+		b.WriteByte('(')
+	}
+	if err := format(b, filter); err != nil {
+		return err
+	}
+	if parens {
+		b.WriteByte(')')
+	}
+	return nil
+}
+
+func formatMatch(b *strings.Builder, match *clientmodels.Match) error {
+	if match.Field == "" {
+		return fmt.Errorf("match without field")
+	}
+
+	b.WriteString(quoteIfNeeded(match.Field, true))
+	if match.JSONPath != nil {
+		if !balancedBrackets(*match.JSONPath) {
+			return fmt.Errorf("JSONPath %q has unbalanced brackets", *match.JSONPath)
+		}
+		b.WriteString("[" + *match.JSONPath + "]")
+	}
+	b.WriteByte(':')
+
+	switch match.Operator {
+	case clientmodels.IsOperator:
+		b.WriteString(quoteIfNeeded(match.Value, false))
+	case clientmodels.ContainsOperator:
+		b.WriteString("~" + quoteIfNeeded(match.Value, false))
+	case clientmodels.MatchesRegexOperator:
+		if match.Value == "" {
+			return fmt.Errorf("empty regex for field %q", match.Field)
+		}
+		b.WriteString("/" + strings.ReplaceAll(match.Value, "/", `\/`) + "/")
+	case clientmodels.ExistsOperator:
+		b.WriteByte('*')
+	default:
+		return fmt.Errorf("unsupported operator %q for field %q", match.Operator, match.Field)
+	}
+
+	return nil
+}
+
+// quoteIfNeeded returns s as a bare word if it parses back to s, and as a
+// quoted string otherwise.
+func quoteIfNeeded(s string, isField bool) string {
+	bare := s != "" && s != andKeyword && s != orKeyword && s != notKeyword
+	if bare && !isField && (s == "*" || s[0] == '/' || s[0] == '~') {
+		bare = false
+	}
+	for i := 0; bare && i < len(s); i++ {
+		c := s[i]
+		if isDelimiter(c) || c == '"' || (isField && (c == ':' || c == '[')) {
+			bare = false
+		}
+	}
+	if bare {
+		return s
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func balancedBrackets(s string) bool {
+	depth := 0
+	for _, c := range s {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
@@ -0,0 +1,90 @@
+package logfilter
+
+import (
+	"testing"
+
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: `service:checkout AND level:(error OR fatal) AND NOT msg:"healthcheck"`,
+			want:  `service:checkout AND (level:error OR level:fatal) AND NOT msg:healthcheck`,
+		},
+		{
+			query: "a:1   b:2 OR c:3",
+			want:  "(a:1 AND b:2) OR c:3",
+		},
+		{
+			query: "NOT (a:1 OR b:2)",
+			want:  "NOT (a:1 OR b:2)",
+		},
+		{
+			query: `msg:~"connection reset" container:/a\/b/ namespace:* url:http://x`,
+			want:  `msg:~"connection reset" AND container:/a\/b/ AND namespace:* AND url:http://x`,
+		},
+		{
+			query: `"log level":"*" v:"AND" w:"/x" x:"" y:"a\\b(c)"`,
+			want:  `"log level":"*" AND v:"AND" AND w:"/x" AND x:"" AND y:"a\\b(c)"`,
+		},
+		{
+			query: "payload[$.items[0]]:42",
+			want:  "payload[$.items[0]]:42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := Canonicalize(tt.query)
+			assert.Nil(t, err)
+			assert.Equal(t, got, tt.want)
+
+			// The canonical form is a fixed point.
+			again, err := Canonicalize(got)
+			assert.Nil(t, err)
+			assert.Equal(t, again, got)
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *clientmodels.LogFilter
+	}{
+		{
+			name:   "empty filter",
+			filter: &clientmodels.LogFilter{},
+		},
+		{
+			name: "more than one kind",
+			filter: &clientmodels.LogFilter{
+				Match:    is("a", "1").Match,
+				MatchNot: Not(is("b", "2")).MatchNot,
+			},
+		},
+		{
+			name:   "unknown operator",
+			filter: match("a", "starts with", "1"),
+		},
+		{
+			name:   "empty all",
+			filter: All(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Format(tt.filter)
+			if err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
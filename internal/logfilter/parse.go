@@ -0,0 +1,484 @@
+// Package logfilter converts log filters between clientmodels.LogFilter trees
+// and the query syntax used in the Oodle UI, e.g.
+//
+//	service:checkout AND level:(error OR fatal) AND NOT msg:"healthcheck"
+//
+// A query is a boolean expression of field matches:
+//
+//	field:value        field is value
+//	field:~value       field contains value
+//	field:/regex/      field matches regex
+//	field:*            field exists
+//	field[path]:value  value at the JSONPath path within field is value
+//	field:(a OR b)     shorthand for (field:a OR field:b)
+//
+// Values and field names containing whitespace or special characters are
+// written as double quoted strings, with '\' escaping '"' and '\'. Within a
+// regex, '\/' stands for '/'. Expressions are combined with NOT, AND and OR,
+// in decreasing order of precedence, and grouped with parentheses. Adjacent
+// expressions without an operator are combined with AND.
+package logfilter
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+const (
+	andKeyword = "AND"
+	orKeyword  = "OR"
+	notKeyword = "NOT"
+)
+
+// ParseError is returned for queries that are not valid.
+type ParseError struct {
+	// Query is the query that failed to parse.
+	Query string
+	// Offset is the byte offset in Query where the error was detected.
+	Offset int
+	// Msg describes the error.
+	Msg string
+}
+
+// Line returns the 1-based line and column of the error.
+func (e *ParseError) Line() (line int, column int) {
+	before := e.Query[:e.Offset]
+	line = strings.Count(before, "\n") + 1
+	column = len([]rune(before[strings.LastIndex(before, "\n")+1:])) + 1
+	return line, column
+}
+
+func (e *ParseError) Error() string {
+	line, column := e.Line()
+	return fmt.Sprintf("%s at line %d, column %d", e.Msg, line, column)
+}
+
+// Snippet returns the line of the query holding the error with a caret
+// pointing at the error.
+func (e *ParseError) Snippet() string {
+	_, column := e.Line()
+	start := strings.LastIndex(e.Query[:e.Offset], "\n") + 1
+	end := strings.IndexByte(e.Query[e.Offset:], '\n')
+	if end < 0 {
+		end = len(e.Query)
+	} else {
+		end += e.Offset
+	}
+
+	return e.Query[start:end] + "\n" + strings.Repeat(" ", column-1) + "^"
+}
+
+// Parse parses query into a log filter.
+func Parse(query string) (*clientmodels.LogFilter, error) {
+	p := &parser{query: query}
+	p.skipSpace()
+	if p.eof() {
+		return nil, p.errorf("empty query")
+	}
+
+	filter, err := p.parseOr(nil)
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		if p.peek() == ')' {
+			return nil, p.errorf("unexpected ')'")
+		}
+		return nil, p.errorf("expected AND, OR or end of query")
+	}
+
+	return filter, nil
+}
+
+// fieldRef is the field that the values of a field group are matched
+// against.
+type fieldRef struct {
+	name     string
+	jsonPath *string
+}
+
+type parser struct {
+	query string
+	pos   int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.query)
+}
+
+func (p *parser) peek() byte {
+	return p.query[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && isSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *parser) errorf(format string, args ...any) *ParseError {
+	return p.errorAt(p.pos, format, args...)
+}
+
+func (p *parser) errorAt(offset int, format string, args ...any) *ParseError {
+	return &ParseError{
+		Query:  p.query,
+		Offset: offset,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// keyword returns the keyword at the current position, if any.
+func (p *parser) keyword() string {
+	for _, kw := range []string{andKeyword, orKeyword, notKeyword} {
+		end := p.pos + len(kw)
+		if strings.HasPrefix(p.query[p.pos:], kw) && (end == len(p.query) || isDelimiter(p.query[end])) {
+			return kw
+		}
+	}
+	return ""
+}
+
+// atExpressionEnd reports whether the current position ends an expression,
+// either because the query or the enclosing group ends.
+func (p *parser) atExpressionEnd() bool {
+	return p.eof() || p.peek() == ')'
+}
+
+// parseOr parses expressions joined by OR. When field is set, the operands
+// are values matched against field rather than field matches.
+func (p *parser) parseOr(field *fieldRef) (*clientmodels.LogFilter, error) {
+	var operands []*clientmodels.LogFilter
+	for {
+		operand, err := p.parseAnd(field)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+
+		if p.keyword() != orKeyword {
+			break
+		}
+		p.pos += len(orKeyword)
+		p.skipSpace()
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return Any(operands...), nil
+}
+
+// parseAnd parses expressions joined by AND or by juxtaposition.
+func (p *parser) parseAnd(field *fieldRef) (*clientmodels.LogFilter, error) {
+	var operands []*clientmodels.LogFilter
+	for {
+		operand, err := p.parseUnary(field)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+
+		if p.keyword() == andKeyword {
+			p.pos += len(andKeyword)
+			p.skipSpace()
+			continue
+		}
+		if p.atExpressionEnd() || p.keyword() == orKeyword {
+			break
+		}
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return All(operands...), nil
+}
+
+func (p *parser) parseUnary(field *fieldRef) (*clientmodels.LogFilter, error) {
+	switch {
+	case p.atExpressionEnd():
+		if p.eof() {
+			return nil, p.errorf("unexpected end of query")
+		}
+		return nil, p.errorf("unexpected ')'")
+	case p.keyword() == notKeyword:
+		p.pos += len(notKeyword)
+		p.skipSpace()
+		operand, err := p.parseUnary(field)
+		if err != nil {
+			return nil, err
+		}
+		return Not(operand), nil
+	case p.keyword() != "":
+		return nil, p.errorf("unexpected %v", p.keyword())
+	case p.peek() == '(':
+		return p.parseGroup(field)
+	case field != nil:
+		return p.parseValue(field)
+	default:
+		return p.parseMatch()
+	}
+}
+
+// parseGroup parses a parenthesized expression.
+func (p *parser) parseGroup(field *fieldRef) (*clientmodels.LogFilter, error) {
+	open := p.pos
+	p.pos++
+	p.skipSpace()
+
+	filter, err := p.parseOr(field)
+	if err != nil {
+		return nil, err
+	}
+	if p.eof() {
+		return nil, p.errorAt(open, "unclosed '('")
+	}
+	p.pos++
+	p.skipSpace()
+
+	return filter, nil
+}
+
+// parseMatch parses a field followed by a value or a group of values.
+func (p *parser) parseMatch() (*clientmodels.LogFilter, error) {
+	start := p.pos
+	name, err := p.parseWord(true)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, p.errorf("expected field name")
+	}
+	field := &fieldRef{name: name}
+
+	if !p.eof() && p.peek() == '[' {
+		jsonPath, err := p.parseJSONPath()
+		if err != nil {
+			return nil, err
+		}
+		field.jsonPath = &jsonPath
+	}
+
+	if p.eof() || p.peek() != ':' {
+		return nil, p.errorAt(start, "expected ':' after field %q", name)
+	}
+	p.pos++
+
+	if !p.eof() && p.peek() == '(' {
+		return p.parseGroup(field)
+	}
+	if p.eof() || isSpace(p.peek()) {
+		return nil, p.errorf("expected value for field %q", name)
+	}
+
+	return p.parseValue(field)
+}
+
+// parseJSONPath parses a bracketed JSONPath, which may itself contain
+// balanced brackets.
+func (p *parser) parseJSONPath() (string, error) {
+	open := p.pos
+	p.pos++
+	depth := 1
+	for start := p.pos; !p.eof(); p.pos++ {
+		switch p.peek() {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				path := p.query[start:p.pos]
+				p.pos++
+				if path == "" {
+					return "", p.errorAt(open, "empty JSONPath")
+				}
+				return path, nil
+			}
+		}
+	}
+
+	return "", p.errorAt(open, "unclosed '['")
+}
+
+// parseValue parses a single value matched against field.
+func (p *parser) parseValue(field *fieldRef) (*clientmodels.LogFilter, error) {
+	match := &clientmodels.Match{
+		Field:    field.name,
+		JSONPath: field.jsonPath,
+	}
+
+	switch p.peek() {
+	case '/':
+		regex, err := p.parseRegex()
+		if err != nil {
+			return nil, err
+		}
+		match.Operator = clientmodels.MatchesRegexOperator
+		match.Value = regex
+	case '~':
+		p.pos++
+		if p.eof() || isDelimiter(p.peek()) {
+			return nil, p.errorf("expected value after '~'")
+		}
+		value, err := p.parseWord(false)
+		if err != nil {
+			return nil, err
+		}
+		match.Operator = clientmodels.ContainsOperator
+		match.Value = value
+	default:
+		if strings.HasPrefix(p.query[p.pos:], "*") && (p.pos+1 == len(p.query) || isDelimiter(p.query[p.pos+1])) {
+			p.pos++
+			match.Operator = clientmodels.ExistsOperator
+			break
+		}
+		value, err := p.parseWord(false)
+		if err != nil {
+			return nil, err
+		}
+		match.Operator = clientmodels.IsOperator
+		match.Value = value
+	}
+	p.skipSpace()
+
+	return &clientmodels.LogFilter{Match: match}, nil
+}
+
+// parseRegex parses a regex delimited by '/'.
+func (p *parser) parseRegex() (string, error) {
+	open := p.pos
+	p.pos++
+	var b strings.Builder
+	for ; !p.eof(); p.pos++ {
+		c := p.peek()
+		switch {
+		case c == '\\' && p.pos+1 < len(p.query) && p.query[p.pos+1] == '/':
+			b.WriteByte('/')
+			p.pos++
+		case c == '/':
+			p.pos++
+			if b.Len() == 0 {
+				return "", p.errorAt(open, "empty regex")
+			}
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", p.errorAt(open, "unterminated regex")
+}
+
+// parseWord parses a quoted string or a bare word. Bare field names end at
+// ':' or '[', bare values only at whitespace or parentheses.
+func (p *parser) parseWord(isField bool) (string, error) {
+	if !p.eof() && p.peek() == '"' {
+		return p.parseQuoted()
+	}
+
+	start := p.pos
+	for !p.eof() {
+		c := p.peek()
+		if isDelimiter(c) || (isField && (c == ':' || c == '[')) {
+			break
+		}
+		if c == '"' {
+			return "", p.errorf("unexpected '\"' in %v", wordKind(isField))
+		}
+		p.pos++
+	}
+
+	return p.query[start:p.pos], nil
+}
+
+func (p *parser) parseQuoted() (string, error) {
+	open := p.pos
+	p.pos++
+	var b strings.Builder
+	for ; !p.eof(); p.pos++ {
+		c := p.peek()
+		switch c {
+		case '\\':
+			if p.pos+1 == len(p.query) {
+				return "", p.errorAt(open, "unterminated string")
+			}
+			next := p.query[p.pos+1]
+			if next != '"' && next != '\\' {
+				return "", p.errorf("invalid escape sequence '\\%c'", next)
+			}
+			b.WriteByte(next)
+			p.pos++
+		case '"':
+			p.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", p.errorAt(open, "unterminated string")
+}
+
+func wordKind(isField bool) string {
+	if isField {
+		return "field name"
+	}
+	return "value"
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDelimiter(c byte) bool {
+	return isSpace(c) || c == '(' || c == ')'
+}
+
+// All returns a filter that matches when all of filters match.
+func All(filters ...*clientmodels.LogFilter) *clientmodels.LogFilter {
+	return &clientmodels.LogFilter{MatchAll: &clientmodels.MatchAll{All: flatten(filters, isAll)}}
+}
+
+// Any returns a filter that matches when any of filters matches.
+func Any(filters ...*clientmodels.LogFilter) *clientmodels.LogFilter {
+	return &clientmodels.LogFilter{MatchAny: &clientmodels.MatchAny{Any: flatten(filters, isAny)}}
+}
+
+// Not returns a filter that matches when filter does not match.
+func Not(filter *clientmodels.LogFilter) *clientmodels.LogFilter {
+	return &clientmodels.LogFilter{MatchNot: &clientmodels.MatchNot{Not: filter}}
+}
+
+// flatten inlines the operands of filters that are of the same kind, so that
+// "a AND (b AND c)" becomes a single list of three filters.
+func flatten(
+	filters []*clientmodels.LogFilter,
+	sameKind func(*clientmodels.LogFilter) []*clientmodels.LogFilter,
+) []*clientmodels.LogFilter {
+	var res []*clientmodels.LogFilter
+	for _, f := range filters {
+		if nested := sameKind(f); nested != nil {
+			res = append(res, nested...)
+			continue
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+func isAll(f *clientmodels.LogFilter) []*clientmodels.LogFilter {
+	if f.MatchAll != nil && f.Match == nil && f.MatchAny == nil && f.MatchNot == nil {
+		return f.MatchAll.All
+	}
+	return nil
+}
+
+func isAny(f *clientmodels.LogFilter) []*clientmodels.LogFilter {
+	if f.MatchAny != nil && f.Match == nil && f.MatchAll == nil && f.MatchNot == nil {
+		return f.MatchAny.Any
+	}
+	return nil
+}
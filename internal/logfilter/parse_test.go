@@ -0,0 +1,162 @@
+package logfilter
+
+import (
+	"testing"
+
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func match(field string, op clientmodels.MatchOperator, value string) *clientmodels.LogFilter {
+	return &clientmodels.LogFilter{Match: &clientmodels.Match{Field: field, Operator: op, Value: value}}
+}
+
+func is(field string, value string) *clientmodels.LogFilter {
+	return match(field, clientmodels.IsOperator, value)
+}
+
+func TestParse(t *testing.T) {
+	jsonPath := "$.items[0].id"
+	tests := []struct {
+		query string
+		want  *clientmodels.LogFilter
+	}{
+		{
+			query: "service:checkout",
+			want:  is("service", "checkout"),
+		},
+		{
+			query: `service:checkout AND level:(error OR fatal) AND NOT msg:"healthcheck"`,
+			want: All(
+				is("service", "checkout"),
+				Any(is("level", "error"), is("level", "fatal")),
+				Not(is("msg", "healthcheck")),
+			),
+		},
+		{
+			query: "a:1 b:2 OR c:3",
+			want:  Any(All(is("a", "1"), is("b", "2")), is("c", "3")),
+		},
+		{
+			query: "a:1 AND (b:2 AND c:3)",
+			want:  All(is("a", "1"), is("b", "2"), is("c", "3")),
+		},
+		{
+			query: "NOT (a:1 OR b:2)",
+			want:  Not(Any(is("a", "1"), is("b", "2"))),
+		},
+		{
+			query: `msg:~"connection reset" container:/(checkout|payment)\/v[0-9]+/ namespace:*`,
+			want: All(
+				match("msg", clientmodels.ContainsOperator, "connection reset"),
+				match("container", clientmodels.MatchesRegexOperator, "(checkout|payment)/v[0-9]+"),
+				match("namespace", clientmodels.ExistsOperator, ""),
+			),
+		},
+		{
+			query: "payload[$.items[0].id]:(42 OR NOT 43)",
+			want: Any(
+				&clientmodels.LogFilter{Match: &clientmodels.Match{
+					Field: "payload", JSONPath: &jsonPath, Operator: clientmodels.IsOperator, Value: "42",
+				}},
+				Not(&clientmodels.LogFilter{Match: &clientmodels.Match{
+					Field: "payload", JSONPath: &jsonPath, Operator: clientmodels.IsOperator, Value: "43",
+				}}),
+			),
+		},
+		{
+			query: `"log level":"say \"hi\"" url:http://example.com/a ORDER:1`,
+			want: All(
+				is("log level", `say "hi"`),
+				is("url", "http://example.com/a"),
+				is("ORDER", "1"),
+			),
+		},
+		{
+			query: "\n  level:error\n  OR level:warn\n",
+			want:  Any(is("level", "error"), is("level", "warn")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := Parse(tt.query)
+			assert.Nil(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantErr string
+		snippet string
+	}{
+		{
+			query:   "",
+			wantErr: "empty query at line 1, column 1",
+		},
+		{
+			query:   "service checkout",
+			wantErr: `expected ':' after field "service" at line 1, column 1`,
+		},
+		{
+			query:   "service:checkout AND",
+			wantErr: "unexpected end of query at line 1, column 21",
+		},
+		{
+			query:   "service:checkout AND OR level:error",
+			wantErr: "unexpected OR at line 1, column 22",
+			snippet: "service:checkout AND OR level:error\n                     ^",
+		},
+		{
+			query:   "level:(error OR fatal",
+			wantErr: "unclosed '(' at line 1, column 7",
+		},
+		{
+			query:   "level:error)",
+			wantErr: "unexpected ')' at line 1, column 12",
+		},
+		{
+			query:   "level:error\n  msg:\"unterminated",
+			wantErr: "unterminated string at line 2, column 7",
+			snippet: "  msg:\"unterminated\n      ^",
+		},
+		{
+			query:   "msg:/unterminated",
+			wantErr: "unterminated regex at line 1, column 5",
+		},
+		{
+			query:   "payload[$.a:1",
+			wantErr: "unclosed '[' at line 1, column 8",
+		},
+		{
+			query:   "msg: x",
+			wantErr: `expected value for field "msg" at line 1, column 5`,
+		},
+		{
+			query:   `msg:"\n"`,
+			wantErr: `invalid escape sequence '\n' at line 1, column 6`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := Parse(tt.query)
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", tt.wantErr)
+			}
+			assert.Equal(t, err.Error(), tt.wantErr)
+
+			parseErr, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("expected *ParseError, got %T", err)
+			}
+			if tt.snippet != "" {
+				assert.Equal(t, parseErr.Snippet(), tt.snippet)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package metricselector converts metric series selectors written in the
+// PromQL style, e.g.
+//
+//	http_requests_total{env="dev",pod=~"canary-.*"}
+//
+// to and from the metric name and label matchers used by metric rules.
+//
+// A selector starts with an optional metric name, followed by an optional
+// comma-separated list of label matchers in braces. Each matcher is a label
+// name, one of '=', '!=', '=~' or '!~', and a value, usually double quoted
+// with '\' escaping '"', '\' and newlines. The metric name can also be matched
+// with a __name__ matcher, e.g. {__name__=~"go_gc_.*"}, but it must be
+// matched exactly once.
+package metricselector
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// MetricNameLabel is the label holding the name of a metric.
+const MetricNameLabel = "__name__"
+
+var (
+	metricNamePrefix = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*`)
+	metricName       = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+	valueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// Parse parses a selector into the matcher of the metric name and the
+// matchers of the other labels, in the order they were written.
+func Parse(selector string) (clientmodels.LabelMatcher, []clientmodels.LabelMatcher, error) {
+	var name *clientmodels.LabelMatcher
+
+	rest := strings.TrimSpace(selector)
+	if prefix := metricNamePrefix.FindString(rest); prefix != "" {
+		name = &clientmodels.LabelMatcher{
+			Name:  MetricNameLabel,
+			Type:  amlabels.MatchEqual,
+			Value: prefix,
+		}
+		rest = strings.TrimSpace(rest[len(prefix):])
+	}
+
+	var filters []clientmodels.LabelMatcher
+	if rest != "" {
+		if !strings.HasPrefix(rest, "{") || !strings.HasSuffix(rest, "}") {
+			if name != nil {
+				return clientmodels.LabelMatcher{}, nil, fmt.Errorf(
+					"invalid selector %q: the metric name must be followed by label matchers in braces", selector)
+			}
+			return clientmodels.LabelMatcher{}, nil, fmt.Errorf(
+				"invalid selector %q: expected a metric name or label matchers in braces", selector)
+		}
+
+		matchers, err := amlabels.ParseMatchers(rest[1 : len(rest)-1])
+		if err != nil {
+			return clientmodels.LabelMatcher{}, nil, fmt.Errorf("invalid selector %q: %v", selector, err)
+		}
+		for _, m := range matchers {
+			matcher := clientmodels.LabelMatcher{Name: m.Name, Type: m.Type, Value: m.Value}
+			if m.Name != MetricNameLabel {
+				filters = append(filters, matcher)
+				continue
+			}
+			if name != nil {
+				return clientmodels.LabelMatcher{}, nil, fmt.Errorf(
+					"invalid selector %q: the metric name can only be matched once", selector)
+			}
+			name = &matcher
+		}
+	}
+
+	if name == nil {
+		return clientmodels.LabelMatcher{}, nil, fmt.Errorf(
+			"invalid selector %q: a metric name or a %v matcher is required", selector, MetricNameLabel)
+	}
+	return *name, filters, nil
+}
+
+// Format returns the selector for a metric name matcher and label matchers.
+// The metric name is written before the braces when it is matched exactly,
+// so that selectors read as they would in PromQL.
+func Format(name clientmodels.LabelMatcher, filters []clientmodels.LabelMatcher) string {
+	var matchers []string
+	prefix := ""
+	if name.Name == MetricNameLabel && name.Type == amlabels.MatchEqual && metricName.MatchString(name.Value) {
+		prefix = name.Value
+	} else {
+		matchers = append(matchers, formatMatcher(name))
+	}
+	for _, filter := range filters {
+		matchers = append(matchers, formatMatcher(filter))
+	}
+
+	if len(matchers) == 0 {
+		return prefix
+	}
+	return prefix + "{" + strings.Join(matchers, ", ") + "}"
+}
+
+// Canonicalize parses and formats a selector, so that selectors matching the
+// same series in the same way have the same canonical form.
+func Canonicalize(selector string) (string, error) {
+	name, filters, err := Parse(selector)
+	if err != nil {
+		return "", err
+	}
+	return Format(name, filters), nil
+}
+
+func formatMatcher(m clientmodels.LabelMatcher) string {
+	return fmt.Sprintf(`%s%s"%s"`, m.Name, m.Type, valueEscaper.Replace(m.Value))
+}
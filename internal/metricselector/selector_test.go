@@ -0,0 +1,149 @@
+package metricselector
+
+import (
+	"testing"
+
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		selector    string
+		wantName    clientmodels.LabelMatcher
+		wantFilters []clientmodels.LabelMatcher
+	}{
+		{
+			selector: "http_requests_total",
+			wantName: clientmodels.LabelMatcher{Name: "__name__", Type: amlabels.MatchEqual, Value: "http_requests_total"},
+		},
+		{
+			selector: `http_requests_total{env="dev",pod=~"canary-.*"}`,
+			wantName: clientmodels.LabelMatcher{Name: "__name__", Type: amlabels.MatchEqual, Value: "http_requests_total"},
+			wantFilters: []clientmodels.LabelMatcher{
+				{Name: "env", Type: amlabels.MatchEqual, Value: "dev"},
+				{Name: "pod", Type: amlabels.MatchRegexp, Value: "canary-.*"},
+			},
+		},
+		{
+			selector: ` {__name__=~"go_gc_.*", job != "api", } `,
+			wantName: clientmodels.LabelMatcher{Name: "__name__", Type: amlabels.MatchRegexp, Value: "go_gc_.*"},
+			wantFilters: []clientmodels.LabelMatcher{
+				{Name: "job", Type: amlabels.MatchNotEqual, Value: "api"},
+			},
+		},
+		{
+			selector: `job:requests:rate5m {path!~"/health|/ready", msg="a, \"b\""}`,
+			wantName: clientmodels.LabelMatcher{Name: "__name__", Type: amlabels.MatchEqual, Value: "job:requests:rate5m"},
+			wantFilters: []clientmodels.LabelMatcher{
+				{Name: "path", Type: amlabels.MatchNotRegexp, Value: "/health|/ready"},
+				{Name: "msg", Type: amlabels.MatchEqual, Value: `a, "b"`},
+			},
+		},
+		{
+			selector: "up{}",
+			wantName: clientmodels.LabelMatcher{Name: "__name__", Type: amlabels.MatchEqual, Value: "up"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			name, filters, err := Parse(tt.selector)
+			assert.Nil(t, err)
+			assert.DeepEqual(t, name, tt.wantName)
+			assert.DeepEqual(t, filters, tt.wantFilters)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		selector string
+		wantErr  string
+	}{
+		{
+			selector: "",
+			wantErr:  `invalid selector "": a metric name or a __name__ matcher is required`,
+		},
+		{
+			selector: `{env="dev"}`,
+			wantErr:  `invalid selector "{env=\"dev\"}": a metric name or a __name__ matcher is required`,
+		},
+		{
+			selector: `up{__name__="down"}`,
+			wantErr:  `invalid selector "up{__name__=\"down\"}": the metric name can only be matched once`,
+		},
+		{
+			selector: `up env="dev"`,
+			wantErr:  `invalid selector "up env=\"dev\"": the metric name must be followed by label matchers in braces`,
+		},
+		{
+			selector: `env="dev"`,
+			wantErr:  `invalid selector "env=\"dev\"": the metric name must be followed by label matchers in braces`,
+		},
+		{
+			selector: `-up`,
+			wantErr:  `invalid selector "-up": expected a metric name or label matchers in braces`,
+		},
+		{
+			selector: `up{env}`,
+			wantErr:  `invalid selector "up{env}": bad matcher format: env`,
+		},
+		{
+			selector: `up{pod=~"canary-("}`,
+			wantErr:  "invalid selector \"up{pod=~\\\"canary-(\\\"}\": error parsing regexp: missing closing ): `^(?:canary-()$`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			_, _, err := Parse(tt.selector)
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", tt.wantErr)
+			}
+			assert.Equal(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{
+			selector: "http_requests_total",
+			want:     "http_requests_total",
+		},
+		{
+			selector: `http_requests_total{env="dev",pod=~"canary-.*"}`,
+			want:     `http_requests_total{env="dev", pod=~"canary-.*"}`,
+		},
+		{
+			selector: `{__name__="up", job=api}`,
+			want:     `up{job="api"}`,
+		},
+		{
+			selector: `{__name__=~"go_gc_.*"}`,
+			want:     `{__name__=~"go_gc_.*"}`,
+		},
+		{
+			selector: `{__name__!="up", path="a\\b \"c\""}`,
+			want:     `{__name__!="up", path="a\\b \"c\""}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			got, err := Canonicalize(tt.selector)
+			assert.Nil(t, err)
+			assert.Equal(t, got, tt.want)
+
+			again, err := Canonicalize(got)
+			assert.Nil(t, err)
+			assert.Equal(t, again, got)
+		})
+	}
+}
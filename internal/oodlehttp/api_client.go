@@ -2,8 +2,10 @@ package oodlehttp
 
 import (
 	"net/http"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"golang.org/x/oauth2"
 )
 
 const (
@@ -18,31 +20,161 @@ type OodleApiClient struct {
 	Instance      string
 	ApiKey        string
 	Headers       map[string][]string
+
+	// cache holds list responses shared by every client created from this
+	// one. It is nil when caching is disabled.
+	cache *responseCache
+
+	// instances holds the clients for other instances of the same
+	// deployment, shared by every client created from this one.
+	instances *instanceClients
+}
+
+// instanceClients keeps a single client per instance so that all resources
+// targeting an instance share its credentials.
+type instanceClients struct {
+	mu sync.Mutex
+	// apiKeys maps an instance to the API key used to access it. Instances
+	// without an entry use defaultApiKey, which is empty when requests are
+	// authenticated by the transport instead.
+	apiKeys       map[string]string
+	defaultApiKey string
+	clients       map[string]*OodleApiClient
 }
 
-func newHttpClient() *http.Client {
+func newTransport() http.RoundTripper {
 	tr, _ := http.DefaultTransport.(*http.Transport)
 	t := tr.Clone()
 	t.MaxIdleConns = maxConnections
 	t.MaxConnsPerHost = maxConnections
 	t.MaxIdleConnsPerHost = maxConnections
-	return &http.Client{
-		Transport: logging.NewLoggingHTTPTransport(t),
+	return logging.NewLoggingHTTPTransport(t)
+}
+
+// apiKeyHeaders returns the headers authenticating requests with apiKey. An
+// empty key leaves authentication to the transport.
+func apiKeyHeaders(apiKey string) map[string][]string {
+	if apiKey == "" {
+		return map[string][]string{}
+	}
+
+	return map[string][]string{
+		OodleApiKeyHeader: {apiKey},
 	}
 }
 
+// NewInstanceClient creates a client for the given default instance.
+// instanceApiKeys optionally maps other instances of the deployment to the API
+// keys used to access them through ForInstance.
 func NewInstanceClient(
 	deploymentUrl string,
 	instance string,
 	apiKey string,
+	instanceApiKeys map[string]string,
 ) (*OodleApiClient, error) {
-	return &OodleApiClient{
-		HttpClient:    newHttpClient(),
+	httpClient := &http.Client{
+		Transport: newTransport(),
+	}
+	return newClient(httpClient, deploymentUrl, instance, apiKey, instanceApiKeys), nil
+}
+
+// NewCredentialsClient creates a client for the given default instance that
+// authenticates with the API key of source, retrieving a new key whenever the
+// current one is about to expire. Instances without an entry in
+// instanceApiKeys use the same key.
+func NewCredentialsClient(
+	deploymentUrl string,
+	instance string,
+	source CredentialSource,
+	instanceApiKeys map[string]string,
+) (*OodleApiClient, error) {
+	httpClient := &http.Client{
+		Transport: &credentialsTransport{
+			base:   newTransport(),
+			source: source,
+		},
+	}
+	return newClient(httpClient, deploymentUrl, instance, "", instanceApiKeys), nil
+}
+
+// NewTokenClient creates a client for the given default instance that
+// authenticates with bearer access tokens from source instead of an API key.
+// Instances with an entry in instanceApiKeys use that key instead.
+func NewTokenClient(
+	deploymentUrl string,
+	instance string,
+	source oauth2.TokenSource,
+	instanceApiKeys map[string]string,
+) (*OodleApiClient, error) {
+	httpClient := &http.Client{
+		Transport: &tokenTransport{
+			base:   newTransport(),
+			source: source,
+		},
+	}
+	return newClient(httpClient, deploymentUrl, instance, "", instanceApiKeys), nil
+}
+
+func newClient(
+	httpClient *http.Client,
+	deploymentUrl string,
+	instance string,
+	apiKey string,
+	instanceApiKeys map[string]string,
+) *OodleApiClient {
+	client := &OodleApiClient{
+		HttpClient:    httpClient,
 		DeploymentUrl: deploymentUrl,
 		Instance:      instance,
 		ApiKey:        apiKey,
-		Headers: map[string][]string{
-			OodleApiKeyHeader: {apiKey},
+		Headers:       apiKeyHeaders(apiKey),
+		cache:         newResponseCache(),
+		instances: &instanceClients{
+			apiKeys:       instanceApiKeys,
+			defaultApiKey: apiKey,
+			clients:       map[string]*OodleApiClient{},
 		},
-	}, nil
+	}
+	client.instances.clients[instance] = client
+
+	return client
+}
+
+// ForInstance returns the client for the given instance of the same
+// deployment. It shares the connection pool and response cache of c. An
+// empty instance returns c itself.
+func (c *OodleApiClient) ForInstance(instance string) *OodleApiClient {
+	if instance == "" || instance == c.Instance {
+		return c
+	}
+	if c.instances == nil {
+		return c.withInstance(instance, c.ApiKey)
+	}
+
+	c.instances.mu.Lock()
+	defer c.instances.mu.Unlock()
+	if client, ok := c.instances.clients[instance]; ok {
+		return client
+	}
+
+	apiKey, ok := c.instances.apiKeys[instance]
+	if !ok {
+		apiKey = c.instances.defaultApiKey
+	}
+	client := c.withInstance(instance, apiKey)
+	c.instances.clients[instance] = client
+
+	return client
+}
+
+func (c *OodleApiClient) withInstance(instance string, apiKey string) *OodleApiClient {
+	return &OodleApiClient{
+		HttpClient:    c.HttpClient,
+		DeploymentUrl: c.DeploymentUrl,
+		Instance:      instance,
+		ApiKey:        apiKey,
+		Headers:       apiKeyHeaders(apiKey),
+		cache:         c.cache,
+		instances:     c.instances,
+	}
 }
@@ -0,0 +1,43 @@
+package oodlehttp
+
+import (
+	"testing"
+)
+
+func TestOodleApiClientForInstance(t *testing.T) {
+	client, err := NewInstanceClient(
+		"https://example.oodle.ai",
+		"production",
+		"default-key",
+		map[string]string{"staging": "staging-key"},
+	)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	if got := client.ForInstance(""); got != client {
+		t.Error("expected empty instance to return the default client")
+	}
+	if got := client.ForInstance("production"); got != client {
+		t.Error("expected default instance to return the default client")
+	}
+
+	staging := client.ForInstance("staging")
+	if staging.Instance != "staging" || staging.ApiKey != "staging-key" {
+		t.Errorf("expected staging client with staging key, got %q with %q", staging.Instance, staging.ApiKey)
+	}
+	if got := staging.Headers[OodleApiKeyHeader]; len(got) != 1 || got[0] != "staging-key" {
+		t.Errorf("expected staging key header, got %v", got)
+	}
+	if client.ForInstance("staging") != staging {
+		t.Error("expected the staging client to be reused")
+	}
+	if staging.cache != client.cache || staging.HttpClient != client.HttpClient {
+		t.Error("expected instance clients to share the cache and http client")
+	}
+
+	dev := staging.ForInstance("dev")
+	if dev.ApiKey != "default-key" {
+		t.Errorf("expected instance without a key to use the default key, got %q", dev.ApiKey)
+	}
+}
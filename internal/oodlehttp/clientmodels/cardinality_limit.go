@@ -0,0 +1,68 @@
+package clientmodels
+
+// CardinalityLimitAction is what happens to new series once a cardinality
+// limit is reached.
+type CardinalityLimitAction string
+
+const (
+	// DropCardinalityLimitAction drops new series over the limit, and alerts.
+	DropCardinalityLimitAction CardinalityLimitAction = "drop"
+
+	// AlertCardinalityLimitAction only alerts, and keeps ingesting new series.
+	AlertCardinalityLimitAction CardinalityLimitAction = "alert"
+)
+
+// CardinalityLimit caps the number of active series of the metrics it
+// matches.
+type CardinalityLimit struct {
+	// ID is the unique identifier.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the human-readable name for the limit.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// MetricName is the __name__ label matcher that selects which metrics are limited.
+	MetricName *LabelMatcher `json:"metric_name,omitempty" yaml:"metric_name,omitempty"`
+
+	// Filters are optional additional label matchers that further restrict which series are limited.
+	Filters []LabelMatcher `json:"filters" yaml:"filters"`
+
+	// MaxSeries is the maximum number of active series of each matched
+	// metric, or of each value of PerLabel when it is set.
+	MaxSeries int64 `json:"max_series,omitempty" yaml:"max_series,omitempty"`
+
+	// PerLabel is an optional label whose values are limited separately.
+	PerLabel string `json:"per_label,omitempty" yaml:"per_label,omitempty"`
+
+	// Action is what happens to new series once the limit is reached.
+	Action CardinalityLimitAction `json:"action,omitempty" yaml:"action,omitempty"`
+}
+
+// GetID returns the ID of the cardinality limit.
+func (l *CardinalityLimit) GetID() string {
+	return l.ID
+}
+
+// CardinalityLimitUsage is the number of active series counted against a
+// cardinality limit.
+type CardinalityLimitUsage struct {
+	// MaxSeries is the limit the usage is counted against.
+	MaxSeries int64 `json:"max_series,omitempty" yaml:"max_series,omitempty"`
+
+	// Usage lists the active series of each limited metric, and of each
+	// value of the PerLabel label when it is set.
+	Usage []*CardinalityLimitSeries `json:"usage,omitempty" yaml:"usage,omitempty"`
+}
+
+// CardinalityLimitSeries is the number of active series of a metric, or of a
+// value of the label a limit is applied per.
+type CardinalityLimitSeries struct {
+	// MetricName is the name of the metric.
+	MetricName string `json:"metric_name,omitempty" yaml:"metric_name,omitempty"`
+
+	// LabelValue is the value of the PerLabel label, if set on the limit.
+	LabelValue string `json:"label_value,omitempty" yaml:"label_value,omitempty"`
+
+	// ActiveSeries is the number of series that received samples recently.
+	ActiveSeries int64 `json:"active_series,omitempty" yaml:"active_series,omitempty"`
+}
@@ -0,0 +1,52 @@
+package clientmodels
+
+// LogArchive copies matching logs to object storage, e.g. for compliance.
+type LogArchive struct {
+	// ID is the unique identifier.
+	ID ID `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the name of the archive.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Filter selects the logs to archive. If not set, all logs are archived.
+	Filter *LogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
+
+	// S3 is the S3 or S3-compatible destination of the archive.
+	S3 *LogArchiveS3Destination `json:"s3,omitempty" yaml:"s3,omitempty"`
+
+	// UpdatedAtEpochMs is the updated at time in milliseconds since epoch.
+	UpdatedAtEpochMs int64 `json:"updatedAtEpochMs,omitempty" yaml:"updatedAtEpochMs,omitempty"`
+}
+
+// LogArchiveS3Destination is an S3 or S3-compatible bucket to archive logs to.
+type LogArchiveS3Destination struct {
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket,omitempty" yaml:"bucket,omitempty"`
+
+	// Prefix is prepended to the keys of the archived objects.
+	Prefix string `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+
+	// Region is the region of the bucket.
+	Region string `json:"region,omitempty" yaml:"region,omitempty"`
+
+	// Endpoint overrides the S3 endpoint, to archive to an S3-compatible
+	// store such as MinIO.
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+
+	// ForcePathStyle addresses the bucket in the path of the URL instead of
+	// the host name, as most S3-compatible stores require.
+	ForcePathStyle bool `json:"forcePathStyle" yaml:"forcePathStyle"`
+
+	// RoleArn is the IAM role Oodle assumes to write to the bucket.
+	RoleArn string `json:"roleArn,omitempty" yaml:"roleArn,omitempty"`
+
+	// AccessKeyID and SecretAccessKey are static credentials to write to
+	// the bucket, for stores that do not support IAM roles.
+	AccessKeyID     string `json:"accessKeyId,omitempty" yaml:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty" yaml:"secretAccessKey,omitempty"`
+}
+
+// GetID returns the ID of the log archive.
+func (a *LogArchive) GetID() string {
+	return a.ID.UUID.String()
+}
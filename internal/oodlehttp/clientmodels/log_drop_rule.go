@@ -0,0 +1,45 @@
+package clientmodels
+
+// LogDropAction is what a log drop rule does with the logs it matches.
+type LogDropAction string
+
+const (
+	// DropLogDropAction drops all matching logs.
+	DropLogDropAction LogDropAction = "drop"
+
+	// SampleLogDropAction keeps a percentage of matching logs.
+	SampleLogDropAction LogDropAction = "sample"
+)
+
+// LogDropRule drops or samples logs at ingest time.
+type LogDropRule struct {
+	// ID is the unique identifier.
+	ID ID `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the name of the rule.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Filter selects the logs the rule applies to.
+	Filter *LogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
+
+	// Action is what the rule does with matching logs.
+	Action LogDropAction `json:"action,omitempty" yaml:"action,omitempty"`
+
+	// SamplePercentage is the percentage of matching logs to keep. Only
+	// used when Action is SampleLogDropAction.
+	SamplePercentage float64 `json:"samplePercentage,omitempty" yaml:"samplePercentage,omitempty"`
+
+	// SampleKey is the field whose value decides whether a log is kept, so
+	// that all logs with the same value are kept or dropped together. If not
+	// set, logs are sampled independently. Only used when Action is
+	// SampleLogDropAction.
+	SampleKey string `json:"sampleKey,omitempty" yaml:"sampleKey,omitempty"`
+
+	// UpdatedAtEpochMs is the updated at time in milliseconds since epoch.
+	UpdatedAtEpochMs int64 `json:"updatedAtEpochMs,omitempty" yaml:"updatedAtEpochMs,omitempty"`
+}
+
+// GetID returns the ID of the log drop rule.
+func (r *LogDropRule) GetID() string {
+	return r.ID.UUID.String()
+}
@@ -0,0 +1,132 @@
+package clientmodels
+
+// LogPipeline is an ordered list of processors that parse, enrich and redact
+// logs before they are stored.
+type LogPipeline struct {
+	// ID is the unique identifier.
+	ID ID `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the name of the pipeline.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Processors are applied to each log in order. Each processor sees the
+	// fields added or changed by the processors before it.
+	Processors []*LogProcessor `json:"processors,omitempty" yaml:"processors,omitempty"`
+
+	// UpdatedAtEpochMs is the updated at time in milliseconds since epoch.
+	UpdatedAtEpochMs int64 `json:"updatedAtEpochMs,omitempty" yaml:"updatedAtEpochMs,omitempty"`
+}
+
+// LogProcessor is a single step of a log pipeline.
+//
+// It is an oneof type where exactly one of the following is set:
+// - GrokParser
+// - RegexParser
+// - JSONParser
+// - RenameField
+// - RemoveField
+// - AddAttribute
+// - Redact.
+type LogProcessor struct {
+	// Name is an optional human-readable label for the processor.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Filter restricts the processor to matching logs. If not set, the
+	// processor applies to all logs.
+	Filter *LogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
+
+	GrokParser   *GrokParser   `json:"grokParser,omitempty" yaml:"grokParser,omitempty"`
+	RegexParser  *RegexParser  `json:"regexParser,omitempty" yaml:"regexParser,omitempty"`
+	JSONParser   *JSONParser   `json:"jsonParser,omitempty" yaml:"jsonParser,omitempty"`
+	RenameField  *RenameField  `json:"renameField,omitempty" yaml:"renameField,omitempty"`
+	RemoveField  *RemoveField  `json:"removeField,omitempty" yaml:"removeField,omitempty"`
+	AddAttribute *AddAttribute `json:"addAttribute,omitempty" yaml:"addAttribute,omitempty"`
+	Redact       *Redact       `json:"redact,omitempty" yaml:"redact,omitempty"`
+}
+
+// GrokParser parses a field with grok patterns. The named captures of the
+// first matching pattern become fields of the log.
+type GrokParser struct {
+	// Field is the name of the field to parse.
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+
+	// Patterns are tried in order until one matches, e.g.
+	// "%{IP:client} %{WORD:method} %{URIPATHPARAM:path}".
+	Patterns []string `json:"patterns,omitempty" yaml:"patterns,omitempty"`
+
+	// PatternDefinitions are custom patterns that can be referenced from
+	// Patterns, keyed by name.
+	PatternDefinitions map[string]string `json:"patternDefinitions,omitempty" yaml:"patternDefinitions,omitempty"`
+}
+
+// RegexParser parses a field with a regex. Its named capture groups become
+// fields of the log. Regex pattern should be a valid Rust regex.
+type RegexParser struct {
+	// Field is the name of the field to parse.
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+
+	// Regex is the pattern to match against the field.
+	Regex string `json:"regex,omitempty" yaml:"regex,omitempty"`
+}
+
+// JSONParser parses a field holding a JSON object into fields of the log.
+type JSONParser struct {
+	// Field is the name of the field to parse.
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+
+	// TargetField is the field to store the parsed object under. If not set,
+	// the keys of the object are added to the top level of the log.
+	TargetField string `json:"targetField,omitempty" yaml:"targetField,omitempty"`
+}
+
+// RenameField renames a field of the log.
+type RenameField struct {
+	// Field is the current name of the field.
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+
+	// TargetField is the new name of the field.
+	TargetField string `json:"targetField,omitempty" yaml:"targetField,omitempty"`
+
+	// Overwrite replaces an existing field named TargetField. If false, the
+	// field is not renamed when TargetField already exists.
+	Overwrite bool `json:"overwrite,omitempty" yaml:"overwrite,omitempty"`
+}
+
+// RemoveField removes fields from the log.
+type RemoveField struct {
+	// Fields are the names of the fields to remove.
+	Fields []string `json:"fields,omitempty" yaml:"fields,omitempty"`
+}
+
+// AddAttribute adds a field with a static value to the log.
+type AddAttribute struct {
+	// Field is the name of the field to add.
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+
+	// Value is the value of the field.
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// Overwrite replaces the value of an existing field. If false, logs that
+	// already have the field are left unchanged.
+	Overwrite bool `json:"overwrite,omitempty" yaml:"overwrite,omitempty"`
+}
+
+// Redact replaces the parts of fields matching a regex, e.g. to mask
+// personally identifiable information. Regex pattern should be a valid Rust
+// regex.
+type Redact struct {
+	// Fields are the names of the fields to redact.
+	Fields []string `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// Regex is the pattern of the values to redact.
+	Regex string `json:"regex,omitempty" yaml:"regex,omitempty"`
+
+	// Replacement replaces each match. It may reference capture groups of
+	// Regex as $1 or ${name}. Defaults to "[REDACTED]".
+	Replacement string `json:"replacement,omitempty" yaml:"replacement,omitempty"`
+}
+
+// GetID returns the ID of the log pipeline.
+func (p *LogPipeline) GetID() string {
+	return p.ID.UUID.String()
+}
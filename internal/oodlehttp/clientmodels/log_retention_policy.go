@@ -0,0 +1,29 @@
+package clientmodels
+
+// LogRetentionPolicy sets how long matching logs are kept. Policies are
+// evaluated in priority order and the first matching policy applies.
+type LogRetentionPolicy struct {
+	// ID is the unique identifier.
+	ID ID `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the name of the policy.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Filter selects the logs the policy applies to. If not set, the policy
+	// applies to all logs.
+	Filter *LogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
+
+	// RetentionDays is the number of days matching logs are kept.
+	RetentionDays int64 `json:"retentionDays,omitempty" yaml:"retentionDays,omitempty"`
+
+	// Priority orders the evaluation of policies, lowest first.
+	Priority int64 `json:"priority" yaml:"priority"`
+
+	// UpdatedAtEpochMs is the updated at time in milliseconds since epoch.
+	UpdatedAtEpochMs int64 `json:"updatedAtEpochMs,omitempty" yaml:"updatedAtEpochMs,omitempty"`
+}
+
+// GetID returns the ID of the log retention policy.
+func (p *LogRetentionPolicy) GetID() string {
+	return p.ID.UUID.String()
+}
@@ -0,0 +1,47 @@
+package clientmodels
+
+// LogViewSharing is who can see a saved log view.
+type LogViewSharing string
+
+const (
+	// PrivateLogViewSharing restricts a view to its owner.
+	PrivateLogViewSharing LogViewSharing = "private"
+
+	// InstanceLogViewSharing shares a view with all users of the instance.
+	InstanceLogViewSharing LogViewSharing = "instance"
+)
+
+// LogView is a saved log search.
+type LogView struct {
+	// ID is the unique identifier.
+	ID ID `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the name of the view.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Description is an optional description of the view.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Filter selects the logs shown by the view. If not set, all logs are
+	// shown.
+	Filter *LogFilter `json:"filter,omitempty" yaml:"filter,omitempty"`
+
+	// Columns are the log fields shown as columns, in order. If not set,
+	// the default columns are shown.
+	Columns []string `json:"columns,omitempty" yaml:"columns,omitempty"`
+
+	// TimeRange is the time range of the view, relative to when it is
+	// opened (e.g., "15m", "1h").
+	TimeRange string `json:"timeRange,omitempty" yaml:"timeRange,omitempty"`
+
+	// Sharing is who can see the view.
+	Sharing LogViewSharing `json:"sharing,omitempty" yaml:"sharing,omitempty"`
+
+	// UpdatedAtEpochMs is the updated at time in milliseconds since epoch.
+	UpdatedAtEpochMs int64 `json:"updatedAtEpochMs,omitempty" yaml:"updatedAtEpochMs,omitempty"`
+}
+
+// GetID returns the ID of the log view.
+func (v *LogView) GetID() string {
+	return v.ID.UUID.String()
+}
@@ -19,6 +19,11 @@ type LogMetrics struct {
 	// MetricDefinitions defines all the metrics to be created from the logs.
 	MetricDefinitions []*MetricDefinition `json:"metricDefinitions,omitempty" yaml:"metricDefinitions,omitempty"`
 
+	// MaxSeries is the maximum number of series each metric of the rule may
+	// generate. Logs that would create series beyond the limit are dropped.
+	// Zero means no limit.
+	MaxSeries int64 `json:"maxSeries,omitempty" yaml:"maxSeries,omitempty"`
+
 	// UpdatedAtEpochMs is the updated at time in milliseconds since epoch.
 	UpdatedAtEpochMs int64 `json:"updatedAtEpochMs,omitempty" yaml:"updatedAtEpochMs,omitempty"`
 }
@@ -115,8 +120,58 @@ const (
 	CounterMetricDefinition   MetricType = "counter"
 	GaugeMetricDefinition     MetricType = "gauge"
 	HistogramMetricDefinition MetricType = "histogram"
+
+	// DistinctCountMetricDefinition counts the distinct values of a field.
+	DistinctCountMetricDefinition MetricType = "distinct_count"
+
+	// SummaryMetricDefinition reports quantiles of numeric values of a field.
+	SummaryMetricDefinition MetricType = "summary"
+)
+
+// AggregationTemporality is how the values of a metric are aggregated over
+// time.
+type AggregationTemporality string
+
+const (
+	// CumulativeTemporality reports values accumulated since the metric was
+	// first reported.
+	CumulativeTemporality AggregationTemporality = "cumulative"
+
+	// DeltaTemporality reports values accumulated since the previous report.
+	DeltaTemporality AggregationTemporality = "delta"
 )
 
+// HistogramBuckets defines the bucket boundaries of a histogram metric.
+//
+// It is an oneof type where exactly one of Explicit, Exponential or Linear
+// must be set.
+type HistogramBuckets struct {
+	// Explicit lists the upper bounds of the buckets in increasing order.
+	Explicit []float64 `json:"explicit,omitempty" yaml:"explicit,omitempty"`
+
+	// Exponential generates buckets whose bounds grow by a constant factor.
+	Exponential *ExponentialBuckets `json:"exponential,omitempty" yaml:"exponential,omitempty"`
+
+	// Linear generates buckets whose bounds grow by a constant width.
+	Linear *LinearBuckets `json:"linear,omitempty" yaml:"linear,omitempty"`
+}
+
+// ExponentialBuckets generates Count bucket bounds, starting at Start and
+// multiplying each bound by Factor to get the next one.
+type ExponentialBuckets struct {
+	Start  float64 `json:"start" yaml:"start"`
+	Factor float64 `json:"factor" yaml:"factor"`
+	Count  int64   `json:"count" yaml:"count"`
+}
+
+// LinearBuckets generates Count bucket bounds, starting at Start and adding
+// Width to each bound to get the next one.
+type LinearBuckets struct {
+	Start float64 `json:"start" yaml:"start"`
+	Width float64 `json:"width" yaml:"width"`
+	Count int64   `json:"count" yaml:"count"`
+}
+
 // MetricDefinition represents the definition of a metric to be created.
 type MetricDefinition struct {
 	// Name is the name of the metric to be created. Must match Prometheus metric naming rules.
@@ -137,6 +192,36 @@ type MetricDefinition struct {
 	// Regex is an optional regex pattern to extract a numeric value from the field.
 	// Only used when Type is "counter" or "gauge". Cannot be used together with JSONPath.
 	Regex *string `json:"regex,omitempty" yaml:"regex,omitempty"`
+
+	// Unit is the unit of the metric values, e.g. "ms" or "By".
+	Unit string `json:"unit,omitempty" yaml:"unit,omitempty"`
+
+	// Temporality is the aggregation temporality of the metric. Defaults to
+	// cumulative. Not used when Type is "gauge".
+	Temporality AggregationTemporality `json:"temporality,omitempty" yaml:"temporality,omitempty"`
+
+	// Buckets are the bucket boundaries. Only used when Type is "histogram".
+	Buckets *HistogramBuckets `json:"buckets,omitempty" yaml:"buckets,omitempty"`
+
+	// Quantiles are the quantiles to report, each between 0 and 1. Only used
+	// when Type is "summary".
+	Quantiles []float64 `json:"quantiles,omitempty" yaml:"quantiles,omitempty"`
+}
+
+// LogMetricsSeries reports the series currently generated by a log metrics
+// rule.
+type LogMetricsSeries struct {
+	// Metrics lists the active series of each metric of the rule.
+	Metrics []*MetricSeries `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+}
+
+// MetricSeries is the number of active series of a generated metric.
+type MetricSeries struct {
+	// Name is the name of the metric.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// ActiveSeries is the number of series that received samples recently.
+	ActiveSeries int64 `json:"activeSeries,omitempty" yaml:"activeSeries,omitempty"`
 }
 
 // GetID returns the ID of the log metrics rule.
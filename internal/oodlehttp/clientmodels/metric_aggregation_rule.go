@@ -0,0 +1,52 @@
+package clientmodels
+
+// MetricAggregation is how a metric aggregation rule combines the series it
+// matches.
+type MetricAggregation string
+
+const (
+	SumMetricAggregation            MetricAggregation = "sum"
+	MinMetricAggregation            MetricAggregation = "min"
+	MaxMetricAggregation            MetricAggregation = "max"
+	CountMetricAggregation          MetricAggregation = "count"
+	HistogramMergeMetricAggregation MetricAggregation = "histogram_merge"
+)
+
+// MetricAggregationRule aggregates metric time-series at ingest time into a
+// new metric with fewer labels, like a Prometheus recording rule.
+type MetricAggregationRule struct {
+	// ID is the unique identifier.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// RuleName is the human-readable name for the aggregation rule.
+	RuleName string `json:"rule_name,omitempty" yaml:"rule_name,omitempty"`
+
+	// MetricName is the __name__ label matcher that selects which metrics to aggregate.
+	MetricName *LabelMatcher `json:"metric_name,omitempty" yaml:"metric_name,omitempty"`
+
+	// Filters are optional additional label matchers that further restrict which series are aggregated.
+	Filters []LabelMatcher `json:"filters" yaml:"filters"`
+
+	// KeepLabels are the labels kept on the output series. All other labels
+	// are aggregated away. Mutually exclusive with DropLabels.
+	KeepLabels []string `json:"keep_labels,omitempty" yaml:"keep_labels,omitempty"`
+
+	// DropLabels are the labels aggregated away. All other labels are kept on
+	// the output series. Mutually exclusive with KeepLabels.
+	DropLabels []string `json:"drop_labels,omitempty" yaml:"drop_labels,omitempty"`
+
+	// Aggregation is how the matched series are combined.
+	Aggregation MetricAggregation `json:"aggregation,omitempty" yaml:"aggregation,omitempty"`
+
+	// OutputMetricName is the name of the aggregated metric.
+	OutputMetricName string `json:"output_metric_name,omitempty" yaml:"output_metric_name,omitempty"`
+
+	// DropRawSeries drops the matched series once they are aggregated, so
+	// only the output metric is stored.
+	DropRawSeries bool `json:"drop_raw_series" yaml:"drop_raw_series"`
+}
+
+// GetID returns the ID of the metric aggregation rule.
+func (r *MetricAggregationRule) GetID() string {
+	return r.ID
+}
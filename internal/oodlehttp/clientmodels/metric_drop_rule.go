@@ -1,5 +1,18 @@
 package clientmodels
 
+// MetricDropRuleState is whether a metric drop rule drops the series it
+// matches.
+type MetricDropRuleState string
+
+const (
+	// ActiveMetricDropRuleState drops the matched series.
+	ActiveMetricDropRuleState MetricDropRuleState = "active"
+
+	// ObserveMetricDropRuleState only measures the series the rule would
+	// drop, without dropping them.
+	ObserveMetricDropRuleState MetricDropRuleState = "observe"
+)
+
 // MetricDropRule represents a rule for dropping metric time-series at ingest time.
 type MetricDropRule struct {
 	// ID is the unique identifier.
@@ -16,9 +29,41 @@ type MetricDropRule struct {
 
 	// Filters are optional additional label matchers that further restrict which series are dropped.
 	Filters []LabelMatcher `json:"filters" yaml:"filters"`
+
+	// State is whether the rule drops the series it matches.
+	State MetricDropRuleState `json:"state,omitempty" yaml:"state,omitempty"`
 }
 
 // GetID returns the ID of the metric drop rule.
 func (r *MetricDropRule) GetID() string {
 	return r.ID
 }
+
+// MetricDropRuleImpact is the amount of data a metric drop rule drops, or
+// would drop when it is in the observe state.
+type MetricDropRuleImpact struct {
+	// ActiveSeries is the number of active series matched by the rule.
+	ActiveSeries int64 `json:"active_series,omitempty" yaml:"active_series,omitempty"`
+
+	// SamplesPerSecond is the rate of samples of the matched series.
+	SamplesPerSecond float64 `json:"samples_per_second,omitempty" yaml:"samples_per_second,omitempty"`
+
+	// TopMetrics are the metrics with the most matched series, in
+	// decreasing order of active series.
+	TopMetrics []*MetricDropRuleImpactMetric `json:"top_metrics,omitempty" yaml:"top_metrics,omitempty"`
+}
+
+// MetricDropRuleImpactMetric is the amount of data of a single metric matched
+// by a metric drop rule.
+type MetricDropRuleImpactMetric struct {
+	// Name is the name of the metric.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// ActiveSeries is the number of active series of the metric matched by
+	// the rule.
+	ActiveSeries int64 `json:"active_series,omitempty" yaml:"active_series,omitempty"`
+
+	// SamplesPerSecond is the rate of samples of the matched series of the
+	// metric.
+	SamplesPerSecond float64 `json:"samples_per_second,omitempty" yaml:"samples_per_second,omitempty"`
+}
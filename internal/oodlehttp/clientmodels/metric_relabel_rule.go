@@ -0,0 +1,65 @@
+package clientmodels
+
+// RelabelAction is the action of a metric relabel rule, with the semantics of
+// the Prometheus relabel config action of the same name.
+type RelabelAction string
+
+const (
+	ReplaceRelabelAction   RelabelAction = "replace"
+	KeepRelabelAction      RelabelAction = "keep"
+	DropRelabelAction      RelabelAction = "drop"
+	KeepEqualRelabelAction RelabelAction = "keepequal"
+	DropEqualRelabelAction RelabelAction = "dropequal"
+	HashModRelabelAction   RelabelAction = "hashmod"
+	LabelMapRelabelAction  RelabelAction = "labelmap"
+	LabelDropRelabelAction RelabelAction = "labeldrop"
+	LabelKeepRelabelAction RelabelAction = "labelkeep"
+	LowercaseRelabelAction RelabelAction = "lowercase"
+	UppercaseRelabelAction RelabelAction = "uppercase"
+)
+
+// MetricRelabelRule rewrites the labels of metric time-series at ingest time,
+// like a Prometheus metric_relabel_configs entry.
+type MetricRelabelRule struct {
+	// ID is the unique identifier.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// RuleName is the human-readable name for the relabel rule.
+	RuleName string `json:"rule_name,omitempty" yaml:"rule_name,omitempty"`
+
+	// MetricName is the __name__ label matcher that selects which metrics to relabel.
+	MetricName *LabelMatcher `json:"metric_name,omitempty" yaml:"metric_name,omitempty"`
+
+	// Filters are optional additional label matchers that further restrict which series are relabeled.
+	Filters []LabelMatcher `json:"filters" yaml:"filters"`
+
+	// SourceLabels are the labels whose values are concatenated with
+	// Separator and matched against Regex.
+	SourceLabels []string `json:"source_labels,omitempty" yaml:"source_labels,omitempty"`
+
+	// Separator is placed between the concatenated source label values.
+	Separator string `json:"separator,omitempty" yaml:"separator,omitempty"`
+
+	// Regex is matched against the concatenated source label values, or
+	// against label names for the labelmap, labeldrop and labelkeep actions.
+	Regex string `json:"regex,omitempty" yaml:"regex,omitempty"`
+
+	// Modulus is the modulus of the hash of the source label values for the
+	// hashmod action.
+	Modulus uint64 `json:"modulus,omitempty" yaml:"modulus,omitempty"`
+
+	// Replacement is the value written to TargetLabel, in which regex capture
+	// groups can be referenced as $1 or ${name}.
+	Replacement string `json:"replacement,omitempty" yaml:"replacement,omitempty"`
+
+	// TargetLabel is the label the result is written to.
+	TargetLabel string `json:"target_label,omitempty" yaml:"target_label,omitempty"`
+
+	// Action is the relabel action to perform.
+	Action RelabelAction `json:"action,omitempty" yaml:"action,omitempty"`
+}
+
+// GetID returns the ID of the metric relabel rule.
+func (r *MetricRelabelRule) GetID() string {
+	return r.ID
+}
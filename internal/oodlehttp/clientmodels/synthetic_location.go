@@ -0,0 +1,46 @@
+package clientmodels
+
+// SyntheticLocation is a location managed by Oodle that synthetic monitors can
+// run their checks from.
+type SyntheticLocation struct {
+	// ID is the unique identifier, used in the locations of a monitor
+	// (e.g., "aws:us-east-1").
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the human-readable name of the location (e.g., "N. Virginia").
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Cloud is the cloud provider hosting the location (e.g., "aws").
+	Cloud string `json:"cloud,omitempty" yaml:"cloud,omitempty"`
+
+	// Region is the cloud provider region of the location (e.g., "us-east-1").
+	Region string `json:"region,omitempty" yaml:"region,omitempty"`
+}
+
+// GetID returns the ID of the synthetic location.
+func (l *SyntheticLocation) GetID() string {
+	return l.ID
+}
+
+// SyntheticPrivateLocation is a location where synthetic checks are run by an
+// agent deployed in the customer's own network, so that internal endpoints can
+// be probed.
+type SyntheticPrivateLocation struct {
+	// ID is the unique identifier, used in the locations of a monitor.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the human-readable name of the location.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Description is an optional description of the location.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// EnrollmentToken is the token the agent registers with. It is only
+	// returned when the location is created.
+	EnrollmentToken string `json:"enrollment_token,omitempty" yaml:"enrollment_token,omitempty"`
+}
+
+// GetID returns the ID of the synthetic private location.
+func (l *SyntheticPrivateLocation) GetID() string {
+	return l.ID
+}
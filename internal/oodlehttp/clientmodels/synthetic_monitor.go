@@ -13,8 +13,9 @@ type SyntheticMonitorBasicAuth struct {
 // monitor. It is shared by single-step ("http") monitors and by each request
 // in a multi-step monitor.
 type SyntheticMonitorHTTPConfig struct {
-	// URL is the URL to monitor. In multi-step monitors it may reference
-	// variables extracted from earlier steps using {{VAR_NAME}} syntax.
+	// URL is the URL to monitor. It may reference global variables and, in
+	// multi-step monitors, variables extracted from earlier steps using
+	// {{VAR_NAME}} syntax.
 	URL string `json:"url,omitempty" yaml:"url,omitempty"`
 
 	// Method is the HTTP method to use.
@@ -97,13 +98,144 @@ type SyntheticMonitorMultistepConfig struct {
 	Steps []SyntheticMonitorStep `json:"steps" yaml:"steps"`
 }
 
-// SyntheticMonitorRuleConfig represents the rule configuration for a synthetic monitor.
+// SyntheticMonitorTCPConfig is the configuration for a monitor that opens a TCP
+// connection to a host.
+type SyntheticMonitorTCPConfig struct {
+	// Host is the hostname or IP address to connect to.
+	Host string `json:"host" yaml:"host"`
+
+	// Port is the TCP port to connect to.
+	Port int64 `json:"port" yaml:"port"`
+
+	// ExpectedBanner is an optional substring that must appear in the first
+	// data the server sends after the connection is opened.
+	ExpectedBanner string `json:"expected_banner,omitempty" yaml:"expected_banner,omitempty"`
+}
+
+// SyntheticMonitorDNSConfig is the configuration for a monitor that resolves a
+// DNS record.
+type SyntheticMonitorDNSConfig struct {
+	// Hostname is the name to resolve.
+	Hostname string `json:"hostname" yaml:"hostname"`
+
+	// RecordType is the DNS record type to query (e.g., "A", "CNAME").
+	RecordType string `json:"record_type" yaml:"record_type"`
+
+	// Resolver is an optional DNS server to query, as "host" or "host:port".
+	// The system resolver is used when empty.
+	Resolver string `json:"resolver,omitempty" yaml:"resolver,omitempty"`
+
+	// ExpectedAnswers are values that must all appear in the answer section.
+	ExpectedAnswers []string `json:"expected_answers,omitempty" yaml:"expected_answers,omitempty"`
+}
+
+// SyntheticMonitorSSLConfig is the configuration for a monitor that checks the
+// TLS certificate served by a host.
+type SyntheticMonitorSSLConfig struct {
+	// Host is the hostname to connect to. It is also sent as the SNI server name.
+	Host string `json:"host" yaml:"host"`
+
+	// Port is the TLS port to connect to. The server defaults to 443 when unset.
+	Port int64 `json:"port,omitempty" yaml:"port,omitempty"`
+
+	// MinDaysUntilExpiry fails the check if the certificate expires in fewer
+	// days than this.
+	MinDaysUntilExpiry int64 `json:"min_days_until_expiry,omitempty" yaml:"min_days_until_expiry,omitempty"`
+
+	// ExpectedIssuer is an optional substring that must appear in the
+	// certificate issuer's distinguished name.
+	ExpectedIssuer string `json:"expected_issuer,omitempty" yaml:"expected_issuer,omitempty"`
+
+	// ExpectedSANs are names that must all appear in the certificate's subject
+	// alternative names.
+	ExpectedSANs []string `json:"expected_sans,omitempty" yaml:"expected_sans,omitempty"`
+}
+
+// SyntheticMonitorGRPCCall is a unary gRPC call made by a gRPC monitor after the
+// health check passes.
+type SyntheticMonitorGRPCCall struct {
+	// Method is the fully qualified method to call, as
+	// "package.Service/Method". The server resolves it through reflection.
+	Method string `json:"method" yaml:"method"`
+
+	// Body is the request message, encoded as a JSON object.
+	Body string `json:"body,omitempty" yaml:"body,omitempty"`
+
+	// ExpectedStatus is the gRPC status code the call must return (e.g.,
+	// "OK", "NOT_FOUND").
+	ExpectedStatus string `json:"expected_status,omitempty" yaml:"expected_status,omitempty"`
+
+	// ExpectedResponse is an optional substring that must appear in the JSON
+	// encoding of the response message.
+	ExpectedResponse string `json:"expected_response,omitempty" yaml:"expected_response,omitempty"`
+}
+
+// SyntheticMonitorGRPCConfig is the configuration for a monitor that probes a
+// gRPC server through the grpc.health.v1 health checking protocol.
+type SyntheticMonitorGRPCConfig struct {
+	// Target is the server address, as "host:port".
+	Target string `json:"target" yaml:"target"`
+
+	// Service is the service name sent in the health check request. An empty
+	// name checks the health of the server as a whole.
+	Service string `json:"service,omitempty" yaml:"service,omitempty"`
+
+	// TLS indicates whether to connect over TLS.
+	TLS bool `json:"tls" yaml:"tls"`
+
+	// InsecureSkipVerify indicates whether to skip TLS certificate verification.
+	InsecureSkipVerify bool `json:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+
+	// Metadata are optional gRPC metadata headers sent with every request.
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+
+	// ExpectedStatus is the serving status the health check must report
+	// (e.g., "SERVING").
+	ExpectedStatus string `json:"expected_status,omitempty" yaml:"expected_status,omitempty"`
+
+	// Call is an optional unary call made after the health check.
+	Call *SyntheticMonitorGRPCCall `json:"call,omitempty" yaml:"call,omitempty"`
+}
+
+// SyntheticMonitorRuleConfig represents the rule configuration for a synthetic
+// monitor. Exactly one field is set, matching the monitor's rule type.
 type SyntheticMonitorRuleConfig struct {
 	// HTTP is the HTTP rule configuration (rule_type "http").
 	HTTP *SyntheticMonitorHTTPConfig `json:"http,omitempty" yaml:"http,omitempty"`
 
 	// Multistep is the multi-step rule configuration (rule_type "multistep").
 	Multistep *SyntheticMonitorMultistepConfig `json:"multistep,omitempty" yaml:"multistep,omitempty"`
+
+	// TCP is the TCP connection rule configuration (rule_type "tcp").
+	TCP *SyntheticMonitorTCPConfig `json:"tcp,omitempty" yaml:"tcp,omitempty"`
+
+	// DNS is the DNS resolution rule configuration (rule_type "dns").
+	DNS *SyntheticMonitorDNSConfig `json:"dns,omitempty" yaml:"dns,omitempty"`
+
+	// SSL is the TLS certificate rule configuration (rule_type "ssl").
+	SSL *SyntheticMonitorSSLConfig `json:"ssl,omitempty" yaml:"ssl,omitempty"`
+
+	// GRPC is the gRPC health check rule configuration (rule_type "grpc").
+	GRPC *SyntheticMonitorGRPCConfig `json:"grpc,omitempty" yaml:"grpc,omitempty"`
+}
+
+// SyntheticMonitorAlerting configures when a synthetic monitor raises an alert
+// and who is notified.
+type SyntheticMonitorAlerting struct {
+	// FailedLocations is the number of locations that must fail for the alert
+	// to fire, out of all the locations of the monitor.
+	FailedLocations int64 `json:"failed_locations,omitempty" yaml:"failed_locations,omitempty"`
+
+	// ConsecutiveFailures is the number of consecutive failed checks after
+	// which a location counts as failed.
+	ConsecutiveFailures int64 `json:"consecutive_failures,omitempty" yaml:"consecutive_failures,omitempty"`
+
+	// Severity is the severity of the alert ("warn" or "critical").
+	Severity string `json:"severity,omitempty" yaml:"severity,omitempty"`
+
+	// Notifications are evaluated in order, and the first matching
+	// notification is used, as for monitors.
+	Notifications []LabelMatcherNotifications `json:"notifications,omitempty" yaml:"notifications,omitempty"`
 }
 
 // SyntheticMonitor represents a synthetic monitor definition.
@@ -117,7 +249,7 @@ type SyntheticMonitor struct {
 	// Enabled indicates whether the synthetic monitor is active.
 	Enabled bool `json:"enabled" yaml:"enabled"`
 
-	// RuleType is the type of the synthetic monitor rule (e.g., "http", "multistep").
+	// RuleType is the type of the synthetic monitor rule (e.g., "http", "multistep", "tcp").
 	RuleType string `json:"rule_type,omitempty" yaml:"rule_type,omitempty"`
 
 	// RuleConfig is the configuration for the synthetic monitor rule.
@@ -128,6 +260,14 @@ type SyntheticMonitor struct {
 
 	// Timeout is the timeout for each check (e.g., "5s", "10s").
 	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// Locations are the IDs of the managed and private locations that run
+	// the checks. The server picks default locations when empty.
+	Locations []string `json:"locations,omitempty" yaml:"locations,omitempty"`
+
+	// Alerting configures the alert raised when checks fail. It is created
+	// and deleted together with the synthetic monitor.
+	Alerting *SyntheticMonitorAlerting `json:"alerting,omitempty" yaml:"alerting,omitempty"`
 }
 
 // GetID returns the ID of the synthetic monitor.
@@ -0,0 +1,23 @@
+package clientmodels
+
+// SyntheticVariable is a global variable that synthetic monitors interpolate
+// into their requests with {{NAME}} placeholders.
+type SyntheticVariable struct {
+	// ID is the unique identifier.
+	ID string `json:"id,omitempty" yaml:"id,omitempty"`
+
+	// Name is the variable name (uppercase, letter-first, at least 3 characters).
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Value is the value of the variable. It is never returned for secret
+	// variables.
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+
+	// Secret marks the value as write-only, and redacts it in results and logs.
+	Secret bool `json:"secret" yaml:"secret"`
+}
+
+// GetID returns the ID of the synthetic variable.
+func (v *SyntheticVariable) GetID() string {
+	return v.ID
+}
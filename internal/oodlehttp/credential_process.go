@@ -0,0 +1,100 @@
+package oodlehttp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// CredentialProcess is a CredentialSource that obtains credentials by running
+// an external command, typically one that reads them from a secrets manager.
+//
+// The command must print a JSON object to stdout:
+//
+//	{
+//	  "deployment_url": "https://us1.oodle.ai/",
+//	  "instance": "my-instance",
+//	  "api_key": "my-api-key",
+//	  "expires_at": "2025-01-01T00:00:00Z"
+//	}
+//
+// Only api_key is required. Credentials without expires_at are retrieved once,
+// others are retrieved again shortly before they expire.
+type CredentialProcess struct {
+	command string
+	run     func(ctx context.Context, command string) ([]byte, error)
+	now     func() time.Time
+
+	mu      sync.Mutex
+	current *Credentials
+}
+
+var _ CredentialSource = &CredentialProcess{}
+
+// NewCredentialProcess creates a CredentialSource that runs command through
+// the system shell.
+func NewCredentialProcess(command string) *CredentialProcess {
+	return &CredentialProcess{
+		command: command,
+		run:     runShellCommand,
+		now:     time.Now,
+	}
+}
+
+// Retrieve returns the credentials printed by the command, running it again
+// only if the previous credentials are about to expire.
+func (p *CredentialProcess) Retrieve(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.current != nil && !p.current.expiresWithin(p.now(), credentialRefreshWindow) {
+		return *p.current, nil
+	}
+
+	out, err := p.run(ctx, p.command)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("credential process failed: %v", err)
+	}
+
+	var creds Credentials
+	if err = jsoniter.Unmarshal(out, &creds); err != nil {
+		return Credentials{}, fmt.Errorf("credential process returned invalid output: %v", err)
+	}
+	if creds.ApiKey == "" {
+		return Credentials{}, fmt.Errorf("credential process returned no api_key")
+	}
+	if creds.expiresWithin(p.now(), 0) {
+		return Credentials{}, fmt.Errorf("credential process returned credentials that expired at %v", creds.ExpiresAt)
+	}
+
+	p.current = &creds
+	return creds, nil
+}
+
+func runShellCommand(ctx context.Context, command string) ([]byte, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%v: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,67 @@
+package oodlehttp
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// credentialRefreshWindow is how long before their expiry credentials are
+// refreshed, so that a request never goes out with a key about to expire.
+const credentialRefreshWindow = 5 * time.Minute
+
+// Credentials identify an Oodle instance and the API key used to access it.
+type Credentials struct {
+	DeploymentUrl string    `json:"deployment_url"`
+	Instance      string    `json:"instance"`
+	ApiKey        string    `json:"api_key"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// expiresWithin reports whether the credentials expire within d of now.
+// Credentials without an expiry never expire.
+func (c Credentials) expiresWithin(now time.Time, d time.Duration) bool {
+	return !c.ExpiresAt.IsZero() && !now.Add(d).Before(c.ExpiresAt)
+}
+
+// CredentialSource supplies credentials that may change over the lifetime of
+// the provider, such as short-lived API keys.
+type CredentialSource interface {
+	// Retrieve returns credentials that remain valid for at least
+	// credentialRefreshWindow, refreshing them if needed.
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// credentialsTransport sets the API key of every request that does not
+// already carry one from the current credentials of its source.
+type credentialsTransport struct {
+	base   http.RoundTripper
+	source CredentialSource
+}
+
+func (t *credentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if hasApiKey(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	creds, err := t.source.Retrieve(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request it was given, and the
+	// clients share their header maps between requests.
+	req = req.Clone(req.Context())
+	req.Header.Set(OodleApiKeyHeader, creds.ApiKey)
+
+	return t.base.RoundTrip(req)
+}
+
+// hasApiKey reports whether req already carries an API key. Clients set the
+// header without canonicalizing its name, so both forms are checked.
+func hasApiKey(req *http.Request) bool {
+	return len(req.Header[OodleApiKeyHeader]) > 0 || req.Header.Get(OodleApiKeyHeader) != ""
+}
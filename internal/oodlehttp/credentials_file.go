@@ -0,0 +1,103 @@
+package oodlehttp
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is selected.
+const DefaultProfile = "default"
+
+// Profile is a named set of settings read from a credentials file.
+type Profile struct {
+	DeploymentUrl     string
+	Instance          string
+	ApiKey            string
+	CredentialProcess string
+}
+
+// DefaultCredentialsFile returns the path of the credentials file in the home
+// directory of the current user, ~/.oodle/credentials.
+func DefaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".oodle", "credentials"), nil
+}
+
+// LoadProfiles reads every profile from the credentials file at path. The
+// file uses INI syntax, with one section per profile:
+//
+//	[default]
+//	deployment_url = https://us1.oodle.ai/
+//	instance = my-instance
+//	api_key = my-api-key
+//
+//	[production]
+//	credential_process = /usr/local/bin/oodle-credentials production
+//
+// Lines starting with '#' or ';' are comments.
+func LoadProfiles(path string) (map[string]Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles := map[string]Profile{}
+	var name string
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' {
+			if line[len(line)-1] != ']' {
+				return nil, fmt.Errorf("%v:%d: unterminated profile name", path, lineNum)
+			}
+			name = strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("%v:%d: empty profile name", path, lineNum)
+			}
+			profiles[name] = profiles[name]
+			continue
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("%v:%d: setting outside of a profile", path, lineNum)
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%v:%d: expected key = value", path, lineNum)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		profile := profiles[name]
+		switch key {
+		case "deployment_url":
+			profile.DeploymentUrl = value
+		case "instance":
+			profile.Instance = value
+		case "api_key":
+			profile.ApiKey = value
+		case "credential_process":
+			profile.CredentialProcess = value
+		default:
+			return nil, fmt.Errorf("%v:%d: unknown setting %q", path, lineNum, key)
+		}
+		profiles[name] = profile
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,203 @@
+package oodlehttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCredentialProcessRefreshesBeforeExpiry(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	var runs int
+	process := NewCredentialProcess("fetch-oodle-key")
+	process.now = func() time.Time { return now }
+	process.run = func(_ context.Context, command string) ([]byte, error) {
+		if command != "fetch-oodle-key" {
+			t.Errorf("unexpected command %q", command)
+		}
+		runs++
+		return []byte(fmt.Sprintf(
+			`{"deployment_url":"https://us1.oodle.ai/","instance":"prod","api_key":"key-%d","expires_at":%q}`,
+			runs,
+			now.Add(time.Hour).Format(time.RFC3339),
+		)), nil
+	}
+
+	ctx := context.Background()
+	creds, err := process.Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if creds.ApiKey != "key-1" || creds.Instance != "prod" || creds.DeploymentUrl != "https://us1.oodle.ai/" {
+		t.Errorf("unexpected credentials %+v", creds)
+	}
+
+	now = now.Add(50 * time.Minute)
+	if creds, _ = process.Retrieve(ctx); creds.ApiKey != "key-1" {
+		t.Errorf("expected cached key-1, got %q", creds.ApiKey)
+	}
+
+	now = now.Add(6 * time.Minute)
+	if creds, _ = process.Retrieve(ctx); creds.ApiKey != "key-2" {
+		t.Errorf("expected key to be refreshed before expiry, got %q", creds.ApiKey)
+	}
+}
+
+func TestCredentialProcessErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		wantErr string
+	}{
+		{
+			name:    "command fails",
+			command: "echo 'not logged in' >&2; exit 1",
+			wantErr: "not logged in",
+		},
+		{
+			name:    "invalid JSON",
+			command: "echo not-json",
+			wantErr: "invalid output",
+		},
+		{
+			name:    "missing api key",
+			command: `echo '{"instance":"prod"}'`,
+			wantErr: "no api_key",
+		},
+		{
+			name:    "expired",
+			command: `echo '{"api_key":"key","expires_at":"2000-01-01T00:00:00Z"}'`,
+			wantErr: "expired",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCredentialProcess(tt.command).Retrieve(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCredentialsClientSetsApiKey(t *testing.T) {
+	var gotKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKeys = append(gotKeys, strings.Join(r.Header.Values(OodleApiKeyHeader), ","))
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	process := NewCredentialProcess(`echo '{"api_key":"process-key"}'`)
+	client, err := NewCredentialsClient(server.URL, "prod", process, map[string]string{"staging": "staging-key"})
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, instance := range []string{"prod", "staging", "dev"} {
+		if _, err = client.ForInstance(instance).getList(ctx, server.URL+"/"+instance); err != nil {
+			t.Fatalf("expected nil error, got: %v", err)
+		}
+	}
+
+	want := []string{"process-key", "staging-key", "process-key"}
+	if fmt.Sprint(gotKeys) != fmt.Sprint(want) {
+		t.Errorf("expected keys %v, got %v", want, gotKeys)
+	}
+	if _, ok := client.Headers[OodleApiKeyHeader]; ok {
+		t.Error("expected the shared headers to be left untouched")
+	}
+}
+
+func TestLoadProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	content := `
+# Static key
+[default]
+deployment_url = https://us1.oodle.ai/
+instance = my-instance
+api_key = my-api-key
+
+; Key from the secrets manager
+[production]
+credential_process = vault read -field=key secret/oodle
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	want := map[string]Profile{
+		"default": {
+			DeploymentUrl: "https://us1.oodle.ai/",
+			Instance:      "my-instance",
+			ApiKey:        "my-api-key",
+		},
+		"production": {
+			CredentialProcess: "vault read -field=key secret/oodle",
+		},
+	}
+	if len(profiles) != len(want) {
+		t.Fatalf("expected %d profiles, got %v", len(want), profiles)
+	}
+	for name, profile := range want {
+		if profiles[name] != profile {
+			t.Errorf("expected profile %q to be %+v, got %+v", name, profile, profiles[name])
+		}
+	}
+}
+
+func TestLoadProfilesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "setting outside of a profile",
+			content: "api_key = key\n",
+			wantErr: ":1: setting outside of a profile",
+		},
+		{
+			name:    "unterminated profile name",
+			content: "[default\n",
+			wantErr: ":1: unterminated profile name",
+		},
+		{
+			name:    "unknown setting",
+			content: "[default]\napi_token = key\n",
+			wantErr: `:2: unknown setting "api_token"`,
+		},
+		{
+			name:    "missing value",
+			content: "[default]\napi_key\n",
+			wantErr: ":2: expected key = value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "credentials")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := LoadProfiles(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -14,6 +17,10 @@ import (
 
 const grafanaBasePath = "%v/v1/api/instance/%v/grafana"
 
+// grafanaSearchPageSize is the number of dashboards requested per page. It
+// matches Grafana's default search limit.
+const grafanaSearchPageSize = 1000
+
 // GrafanaFolderClient handles Grafana folder operations.
 type GrafanaFolderClient struct {
 	*OodleApiClient
@@ -24,6 +31,16 @@ func NewGrafanaFolderClient(client *OodleApiClient) *GrafanaFolderClient {
 	return &GrafanaFolderClient{OodleApiClient: client}
 }
 
+// ForInstance returns a folder client for the given instance. An empty
+// instance targets the instance of c.
+func (c *GrafanaFolderClient) ForInstance(instance string) *GrafanaFolderClient {
+	return NewGrafanaFolderClient(c.OodleApiClient.ForInstance(instance))
+}
+
+func (c *GrafanaFolderClient) foldersUrl() string {
+	return fmt.Sprintf(grafanaBasePath+"/folders", c.DeploymentUrl, c.Instance)
+}
+
 // Create creates a new folder.
 func (c *GrafanaFolderClient) Create(
 	ctx context.Context,
@@ -47,6 +64,7 @@ func (c *GrafanaFolderClient) Create(
 	req.Header = c.Headers
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.foldersUrl())
 	if err != nil {
 		return nil, err
 	}
@@ -126,38 +144,21 @@ func (c *GrafanaFolderClient) Get(
 func (c *GrafanaFolderClient) List(
 	ctx context.Context,
 ) ([]clientmodels.GrafanaFolder, error) {
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodGet,
-		fmt.Sprintf(grafanaBasePath+"/folders", c.DeploymentUrl, c.Instance),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header = c.Headers
-	resp, err := c.HttpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := io.ReadAll(resp.Body)
+	resp, err := c.getList(ctx, c.foldersUrl())
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.statusCode != http.StatusOK {
 		return nil, fmt.Errorf(
 			"failed to list folders: %v, body: %v",
-			resp.Status,
-			string(bodyBytes),
+			resp.status,
+			string(resp.body),
 		)
 	}
 
 	var result []clientmodels.GrafanaFolder
-	if err = jsoniter.Unmarshal(bodyBytes, &result); err != nil {
+	if err = jsoniter.Unmarshal(resp.body, &result); err != nil {
 		return nil, err
 	}
 
@@ -196,6 +197,9 @@ func (c *GrafanaFolderClient) Update(
 	req.Header = c.Headers
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.foldersUrl())
+	// Dashboard listings embed the folder title.
+	c.invalidateList(fmt.Sprintf(grafanaBasePath+"/dashboards", c.DeploymentUrl, c.Instance))
 	if err != nil {
 		return nil, err
 	}
@@ -242,6 +246,9 @@ func (c *GrafanaFolderClient) Delete(ctx context.Context, uid string) error {
 
 	req.Header = c.Headers
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.foldersUrl())
+	// Deleting a folder also deletes the dashboards it contains.
+	c.invalidateList(fmt.Sprintf(grafanaBasePath+"/dashboards", c.DeploymentUrl, c.Instance))
 	if err != nil {
 		return err
 	}
@@ -274,6 +281,16 @@ func NewGrafanaDashboardClient(client *OodleApiClient) *GrafanaDashboardClient {
 	return &GrafanaDashboardClient{OodleApiClient: client}
 }
 
+// ForInstance returns a dashboard client for the given instance. An empty
+// instance targets the instance of c.
+func (c *GrafanaDashboardClient) ForInstance(instance string) *GrafanaDashboardClient {
+	return NewGrafanaDashboardClient(c.OodleApiClient.ForInstance(instance))
+}
+
+func (c *GrafanaDashboardClient) dashboardsUrl() string {
+	return fmt.Sprintf(grafanaBasePath+"/dashboards", c.DeploymentUrl, c.Instance)
+}
+
 // Create creates a new dashboard.
 func (c *GrafanaDashboardClient) Create(
 	ctx context.Context,
@@ -297,6 +314,7 @@ func (c *GrafanaDashboardClient) Create(
 	req.Header = c.Headers
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.dashboardsUrl())
 	if err != nil {
 		return nil, err
 	}
@@ -372,46 +390,79 @@ func (c *GrafanaDashboardClient) Get(
 	return &result, nil
 }
 
-// List lists all dashboards.
+// List lists all dashboards, following pagination until the last page.
+// Prefer Iterate for large collections.
 func (c *GrafanaDashboardClient) List(
 	ctx context.Context,
 ) ([]clientmodels.GrafanaDashboardListItem, error) {
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodGet,
-		fmt.Sprintf(grafanaBasePath+"/dashboards", c.DeploymentUrl, c.Instance),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
+	return c.Iterate(ctx).Collect()
+}
 
-	req.Header = c.Headers
-	resp, err := c.HttpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// Iterate returns an iterator over all dashboards. Pages are requested lazily
+// as the iterator advances.
+func (c *GrafanaDashboardClient) Iterate(
+	ctx context.Context,
+) *ListIterator[clientmodels.GrafanaDashboardListItem] {
+	return newListIterator[clientmodels.GrafanaDashboardListItem](ctx, c.fetchPage)
+}
 
-	bodyBytes, err := io.ReadAll(resp.Body)
+// fetchPage fetches a single page of dashboards. Like the Grafana search API
+// it proxies, the endpoint pages with 1-based limit/page parameters and a
+// page shorter than the limit marks the end of the results. The token is the
+// page number followed by the UID of the first dashboard on the previous page,
+// as "<page>:<uid>", and is empty for the first page.
+func (c *GrafanaDashboardClient) fetchPage(
+	ctx context.Context,
+	token string,
+) ([]clientmodels.GrafanaDashboardListItem, string, error) {
+	page := 1
+	var prevFirstUID string
+	if token != "" {
+		pageStr, uid, _ := strings.Cut(token, ":")
+		var err error
+		if page, err = strconv.Atoi(pageStr); err != nil {
+			return nil, "", fmt.Errorf("invalid page token %q: %v", token, err)
+		}
+		prevFirstUID = uid
+	}
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(grafanaSearchPageSize))
+	query.Set("page", strconv.Itoa(page))
+
+	resp, err := c.getList(ctx, c.dashboardsUrl()+"?"+query.Encode())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
+	if resp.statusCode != http.StatusOK {
+		return nil, "", fmt.Errorf(
 			"failed to list dashboards: %v, body: %v",
-			resp.Status,
-			string(bodyBytes),
+			resp.status,
+			string(resp.body),
 		)
 	}
 
 	var result []clientmodels.GrafanaDashboardListItem
-	if err = jsoniter.Unmarshal(bodyBytes, &result); err != nil {
-		return nil, err
+	if err = jsoniter.Unmarshal(resp.body, &result); err != nil {
+		return nil, "", err
 	}
 
-	return result, nil
+	// An endpoint that ignores the paging parameters returns the same
+	// dashboards for every page. When a page starts with the same dashboard as
+	// the previous one, it is a repeat and the results are complete.
+	if page > 1 && len(result) > 0 && result[0].UID == prevFirstUID {
+		return nil, "", nil
+	}
+
+	// An empty or short page is the last one. A page longer than the limit
+	// also means that the paging parameters were ignored and everything was
+	// returned at once.
+	if len(result) != grafanaSearchPageSize {
+		return result, "", nil
+	}
+
+	return result, strconv.Itoa(page+1) + ":" + result[0].UID, nil
 }
 
 // Update updates a dashboard.
@@ -442,6 +493,7 @@ func (c *GrafanaDashboardClient) Delete(ctx context.Context, uid string) error {
 
 	req.Header = c.Headers
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.dashboardsUrl())
 	if err != nil {
 		return err
 	}
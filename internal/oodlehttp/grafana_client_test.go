@@ -2,9 +2,15 @@ package oodlehttp
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
 )
 
 func TestGrafanaFolderClientDelete(t *testing.T) {
@@ -50,3 +56,76 @@ func TestGrafanaDashboardClientDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestGrafanaDashboardClientList(t *testing.T) {
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		requestedPages = append(requestedPages, page)
+
+		count := grafanaSearchPageSize
+		if page == "2" {
+			count = 3
+		}
+		items := make([]clientmodels.GrafanaDashboardListItem, count)
+		for i := range items {
+			items[i].UID = fmt.Sprintf("%s-%d", page, i)
+		}
+		_ = jsoniter.NewEncoder(w).Encode(items)
+	}))
+	defer server.Close()
+
+	client := NewGrafanaDashboardClient(newTestOodleAPIClient(server))
+
+	it := client.Iterate(context.Background())
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if count != grafanaSearchPageSize+3 {
+		t.Errorf("expected %d dashboards, got %d", grafanaSearchPageSize+3, count)
+	}
+	if !reflect.DeepEqual(requestedPages, []string{"1", "2"}) {
+		t.Errorf("expected pages [1 2] to be requested, got %v", requestedPages)
+	}
+}
+
+func TestGrafanaDashboardClientListIgnoredPaging(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 3 {
+			// Stop the loop when the client does not.
+			_, _ = w.Write([]byte("[]"))
+			return
+		}
+
+		// Every page holds the same dashboards, whatever page is requested.
+		items := make([]clientmodels.GrafanaDashboardListItem, grafanaSearchPageSize)
+		for i := range items {
+			items[i].UID = fmt.Sprintf("dashboard-%d", i)
+		}
+		_ = jsoniter.NewEncoder(w).Encode(items)
+	}))
+	defer server.Close()
+
+	client := NewGrafanaDashboardClient(newTestOodleAPIClient(server))
+
+	it := client.Iterate(context.Background())
+	count := 0
+	for it.Next() {
+		count++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if count != grafanaSearchPageSize {
+		t.Errorf("expected %d dashboards, got %d", grafanaSearchPageSize, count)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
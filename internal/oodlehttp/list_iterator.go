@@ -0,0 +1,102 @@
+package oodlehttp
+
+import (
+	"context"
+	"fmt"
+)
+
+// pageFetcher fetches the page identified by token, where an empty token
+// identifies the first page. It returns the items on the page and the token
+// of the following page, or an empty token when the page is the last one.
+type pageFetcher[T any] func(ctx context.Context, token string) ([]T, string, error)
+
+// ListIterator walks a paginated collection one item at a time. Pages are
+// fetched lazily as items are consumed, so callers never need to hold more
+// than a single page in memory.
+//
+// Typical usage:
+//
+//	it := client.Iterate(ctx)
+//	for it.Next() {
+//		item := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ListIterator[T any] struct {
+	ctx       context.Context
+	fetch     pageFetcher[T]
+	page      []T
+	pos       int
+	cur       T
+	nextToken string
+	fetched   bool
+	done      bool
+	err       error
+}
+
+func newListIterator[T any](ctx context.Context, fetch pageFetcher[T]) *ListIterator[T] {
+	return &ListIterator[T]{
+		ctx:   ctx,
+		fetch: fetch,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page if the
+// current one is exhausted. It returns false once all items have been
+// consumed or an error occurred; use Err to tell the two apart.
+func (it *ListIterator[T]) Next() bool {
+	for it.err == nil {
+		if it.pos < len(it.page) {
+			it.cur = it.page[it.pos]
+			it.pos++
+			return true
+		}
+		if it.done {
+			return false
+		}
+
+		token := it.nextToken
+		page, nextToken, err := it.fetch(it.ctx, token)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if nextToken != "" && it.fetched && nextToken == token {
+			it.err = fmt.Errorf("pagination did not advance past page %q", token)
+			return false
+		}
+
+		it.fetched = true
+		it.page = page
+		it.pos = 0
+		it.nextToken = nextToken
+		it.done = nextToken == ""
+	}
+
+	return false
+}
+
+// Value returns the item the iterator currently points at.
+func (it *ListIterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ListIterator[T]) Err() error {
+	return it.err
+}
+
+// Collect drains the iterator and returns all remaining items.
+func (it *ListIterator[T]) Collect() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
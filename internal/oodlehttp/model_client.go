@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	jsoniter "github.com/json-iterator/go"
 
@@ -14,6 +16,48 @@ import (
 
 const apiBasePath = "%v/v1/api/instance/%v/"
 
+const (
+	// listPageSize is the number of models requested per page when listing.
+	listPageSize = 500
+
+	limitParam  = "limit"
+	offsetParam = "offset"
+	cursorParam = "cursor"
+)
+
+// listPage is the envelope returned by paginated list endpoints. Cursor based
+// endpoints set NextCursor, while limit/offset based endpoints set Total.
+type listPage[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Total      *int   `json:"total,omitempty"`
+}
+
+// nextToken returns the token of the page following the one requested with
+// query, or an empty token if there are no more pages.
+func (p *listPage[T]) nextToken(query url.Values) string {
+	next := url.Values{}
+	if limit := query.Get(limitParam); limit != "" {
+		next.Set(limitParam, limit)
+	}
+
+	switch {
+	case p.NextCursor != "":
+		next.Set(cursorParam, p.NextCursor)
+	case p.Total != nil && len(p.Items) > 0:
+		offset, _ := strconv.Atoi(query.Get(offsetParam))
+		offset += len(p.Items)
+		if offset >= *p.Total {
+			return ""
+		}
+		next.Set(offsetParam, strconv.Itoa(offset))
+	default:
+		return ""
+	}
+
+	return next.Encode()
+}
+
 // ModelClient is a client is used to access and update models from Oodle APIs.
 type ModelClient[T clientmodels.ClientModel] struct {
 	*OodleApiClient
@@ -35,6 +79,19 @@ func NewModelClient[T clientmodels.ClientModel](
 	}
 }
 
+// ForInstance returns a client for the same models on the given instance.
+// An empty instance targets the instance of c.
+func (c *ModelClient[T]) ForInstance(instance string) *ModelClient[T] {
+	client := *c
+	client.OodleApiClient = c.OodleApiClient.ForInstance(instance)
+	return &client
+}
+
+// collectionUrl returns the URL of the collection holding the models.
+func (c *ModelClient[T]) collectionUrl() string {
+	return fmt.Sprintf(apiBasePath+c.resourcePath, c.DeploymentUrl, c.Instance)
+}
+
 func (c *ModelClient[T]) Get(ctx context.Context, id string) (T, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
@@ -82,6 +139,7 @@ func (c *ModelClient[T]) Delete(ctx context.Context, id string) error {
 
 	req.Header = c.Headers
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.collectionUrl())
 	if err != nil {
 		return err
 	}
@@ -118,6 +176,7 @@ func (c *ModelClient[T]) Create(ctx context.Context, model T) (T, error) {
 
 	req.Header = c.Headers
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.collectionUrl())
 	if err != nil {
 		return c.nilVal, err
 	}
@@ -140,38 +199,56 @@ func (c *ModelClient[T]) Create(ctx context.Context, model T) (T, error) {
 	return resModel, nil
 }
 
+// List returns every model in the collection, following pagination until the
+// last page. Prefer Iterate for large collections.
 func (c *ModelClient[T]) List(ctx context.Context) ([]T, error) {
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodGet,
-		fmt.Sprintf(apiBasePath+c.resourcePath, c.DeploymentUrl, c.Instance),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
+	return c.Iterate(ctx).Collect()
+}
 
-	req.Header = c.Headers
-	resp, err := c.HttpClient.Do(req)
+// Iterate returns an iterator over every model in the collection. Pages are
+// requested lazily as the iterator advances.
+func (c *ModelClient[T]) Iterate(ctx context.Context) *ListIterator[T] {
+	return newListIterator[T](ctx, c.fetchPage)
+}
+
+// fetchPage fetches a single page of models. The token is the encoded query
+// string selecting the page, as produced by the previous call.
+//
+// The endpoint may either respond with a bare JSON array, in which case the
+// whole collection was returned at once, or with a listPage envelope that
+// advertises the next page via a cursor or through limit/offset totals.
+func (c *ModelClient[T]) fetchPage(ctx context.Context, token string) ([]T, string, error) {
+	query, err := url.ParseQuery(token)
 	if err != nil {
-		return nil, err
+		return nil, "", fmt.Errorf("invalid page token %q: %v", token, err)
+	}
+	if query.Get(limitParam) == "" {
+		query.Set(limitParam, strconv.Itoa(listPageSize))
 	}
 
-	defer resp.Body.Close()
-	bodyBytes, err := io.ReadAll(resp.Body)
+	resp, err := c.getList(ctx, c.collectionUrl()+"?"+query.Encode())
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to list models %T: %v, body: %v", c.nilVal, resp.Status, string(bodyBytes))
+	if resp.statusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to list models %T: %v, body: %v", c.nilVal, resp.status, string(resp.body))
+	}
+
+	trimmed := bytes.TrimSpace(resp.body)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		var models []T
+		if err = jsoniter.Unmarshal(resp.body, &models); err != nil {
+			return nil, "", err
+		}
+		return models, "", nil
 	}
 
-	var models []T
-	if err = jsoniter.Unmarshal(bodyBytes, &models); err != nil {
-		return nil, err
+	var page listPage[T]
+	if err = jsoniter.Unmarshal(resp.body, &page); err != nil {
+		return nil, "", err
 	}
 
-	return models, nil
+	return page.Items, page.nextToken(query), nil
 }
 
 func (c *ModelClient[T]) Update(ctx context.Context, model T) (T, error) {
@@ -192,6 +269,7 @@ func (c *ModelClient[T]) Update(ctx context.Context, model T) (T, error) {
 
 	req.Header = c.Headers
 	resp, err := c.HttpClient.Do(req)
+	c.invalidateList(c.collectionUrl())
 	if err != nil {
 		return c.nilVal, err
 	}
@@ -213,3 +291,70 @@ func (c *ModelClient[T]) Update(ctx context.Context, model T) (T, error) {
 
 	return resModel, nil
 }
+
+// GetSubresource fetches a read-only subresource of the model with the given
+// id, such as usage statistics, and decodes it into out.
+func (c *ModelClient[T]) GetSubresource(ctx context.Context, id string, subresource string, out any) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf(apiBasePath+c.resourcePath+"/%s/%s", c.DeploymentUrl, c.Instance, id, subresource),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header = c.Headers
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %v of model %T: %v, body: %v", subresource, c.nilVal, resp.Status, string(bodyBytes))
+	}
+
+	return jsoniter.Unmarshal(bodyBytes, out)
+}
+
+// PostAction posts body to an action of the collection, such as an evaluation
+// of a model that is not saved yet, and decodes the response into out.
+func (c *ModelClient[T]) PostAction(ctx context.Context, action string, body any, out any) error {
+	reqBody, err := jsoniter.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf(apiBasePath+c.resourcePath+"/%s", c.DeploymentUrl, c.Instance, action),
+		bytes.NewReader(reqBody),
+	)
+	if err != nil {
+		return err
+	}
+
+	req.Header = c.Headers
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to post %v of model %T: %v, body: %v", action, c.nilVal, resp.Status, string(bodyBytes))
+	}
+
+	return jsoniter.Unmarshal(bodyBytes, out)
+}
@@ -2,8 +2,10 @@ package oodlehttp
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
@@ -34,3 +36,158 @@ func TestModelClientDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestModelClientList(t *testing.T) {
+	tests := []struct {
+		name      string
+		pages     map[string]string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name: "bare array is a single page",
+			pages: map[string]string{
+				"limit=500": `[{"name":"a"},{"name":"b"}]`,
+			},
+			wantNames: []string{"a", "b"},
+		},
+		{
+			name: "cursor pagination",
+			pages: map[string]string{
+				"limit=500":           `{"items":[{"name":"a"}],"next_cursor":"c1"}`,
+				"cursor=c1&limit=500": `{"items":[{"name":"b"}],"next_cursor":"c2"}`,
+				"cursor=c2&limit=500": `{"items":[{"name":"c"}]}`,
+			},
+			wantNames: []string{"a", "b", "c"},
+		},
+		{
+			name: "offset pagination",
+			pages: map[string]string{
+				"limit=500":          `{"items":[{"name":"a"},{"name":"b"}],"total":3}`,
+				"limit=500&offset=2": `{"items":[{"name":"c"}],"total":3}`,
+			},
+			wantNames: []string{"a", "b", "c"},
+		},
+		{
+			name: "cursor that does not advance",
+			pages: map[string]string{
+				"limit=500":           `{"items":[{"name":"a"}],"next_cursor":"c1"}`,
+				"cursor=c1&limit=500": `{"items":[{"name":"b"}],"next_cursor":"c1"}`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error on a later page",
+			pages: map[string]string{
+				"limit=500": `{"items":[{"name":"a"}],"next_cursor":"c1"}`,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, ok := tt.pages[r.URL.RawQuery]
+				if !ok {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			client := NewModelClient[*clientmodels.Monitor](
+				newTestOodleAPIClient(server),
+				"monitors",
+				func() *clientmodels.Monitor { return &clientmodels.Monitor{} },
+			)
+
+			monitors, err := client.List(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected nil error, got: %v", err)
+			}
+
+			var names []string
+			for _, m := range monitors {
+				names = append(names, m.Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("expected %v, got %v", tt.wantNames, names)
+			}
+		})
+	}
+}
+
+func TestModelClientGetSubresource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/api/instance/test-instance/logmetrics/test-id/series" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"metrics":[{"name":"a","activeSeries":12}]}`))
+	}))
+	defer server.Close()
+
+	client := NewModelClient[*clientmodels.LogMetrics](
+		newTestOodleAPIClient(server),
+		"logmetrics",
+		func() *clientmodels.LogMetrics { return &clientmodels.LogMetrics{} },
+	)
+
+	var series clientmodels.LogMetricsSeries
+	if err := client.GetSubresource(context.Background(), "test-id", "series", &series); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	want := clientmodels.LogMetricsSeries{Metrics: []*clientmodels.MetricSeries{{Name: "a", ActiveSeries: 12}}}
+	if !reflect.DeepEqual(series, want) {
+		t.Errorf("got %+v, want %+v", series, want)
+	}
+
+	if err := client.GetSubresource(context.Background(), "other-id", "series", &series); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestModelClientPostAction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/api/instance/test-instance/drop-rules/impact" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"rule_name":"test","filters":null,"state":"observe"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write(body)
+			return
+		}
+		_, _ = w.Write([]byte(`{"active_series":12,"samples_per_second":0.4}`))
+	}))
+	defer server.Close()
+
+	client := NewModelClient[*clientmodels.MetricDropRule](
+		newTestOodleAPIClient(server),
+		"drop-rules",
+		func() *clientmodels.MetricDropRule { return &clientmodels.MetricDropRule{} },
+	)
+
+	rule := &clientmodels.MetricDropRule{RuleName: "test", State: clientmodels.ObserveMetricDropRuleState}
+	var impact clientmodels.MetricDropRuleImpact
+	if err := client.PostAction(context.Background(), "impact", rule, &impact); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	want := clientmodels.MetricDropRuleImpact{ActiveSeries: 12, SamplesPerSecond: 0.4}
+	if !reflect.DeepEqual(impact, want) {
+		t.Errorf("got %+v, want %+v", impact, want)
+	}
+
+	if err := client.PostAction(context.Background(), "other", rule, &impact); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
@@ -0,0 +1,156 @@
+package oodlehttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// listCacheTTL bounds how long a list response is served from the cache.
+// A provider process lives for a single plan or apply, so this only needs to
+// cover repeated reads within one operation.
+const listCacheTTL = 30 * time.Second
+
+// listResponse is the outcome of a GET request against a list endpoint.
+type listResponse struct {
+	statusCode int
+	status     string
+	body       []byte
+}
+
+type cachedListResponse struct {
+	resp    *listResponse
+	expires time.Time
+}
+
+// inflightList is a GET request that concurrent callers wait on instead of
+// issuing their own.
+type inflightList struct {
+	done chan struct{}
+	resp *listResponse
+	err  error
+}
+
+// responseCache caches successful list responses for a short time and
+// coalesces concurrent identical requests into a single one. Entries are
+// keyed by request URL and dropped whenever the collection they belong to is
+// modified through the same client.
+type responseCache struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	entries  map[string]cachedListResponse
+	inflight map[string]*inflightList
+	// generation is bumped on every invalidation so that responses fetched
+	// before a modification are not stored after it.
+	generation uint64
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		now:      time.Now,
+		entries:  map[string]cachedListResponse{},
+		inflight: map[string]*inflightList{},
+	}
+}
+
+// get returns the response for key, calling fetch only if there is neither
+// a fresh cached response nor an identical request already in flight. A nil
+// cache always calls fetch.
+func (c *responseCache) get(key string, fetch func() (*listResponse, error)) (*listResponse, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		if c.now().Before(entry.expires) {
+			c.mu.Unlock()
+			return entry.resp, nil
+		}
+		delete(c.entries, key)
+	}
+	if call, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.resp, call.err
+	}
+	call := &inflightList{done: make(chan struct{})}
+	c.inflight[key] = call
+	generation := c.generation
+	c.mu.Unlock()
+
+	call.resp, call.err = fetch()
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	if call.err == nil && call.resp.statusCode == http.StatusOK && generation == c.generation {
+		c.entries[key] = cachedListResponse{
+			resp:    call.resp,
+			expires: c.now().Add(listCacheTTL),
+		}
+	}
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.resp, call.err
+}
+
+// invalidate drops every cached response for the collection at
+// collectionUrl, including all of its pages.
+func (c *responseCache) invalidate(collectionUrl string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key := range c.entries {
+		if key == collectionUrl || strings.HasPrefix(key, collectionUrl+"?") {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// getList issues a GET request against a list endpoint, serving it from the
+// response cache when possible. Responses are returned whatever their status
+// so the caller can report failures; only 200 responses are cached.
+//
+// Coalesced callers share the request of whichever caller issued it first,
+// including its context.
+func (c *OodleApiClient) getList(ctx context.Context, requestUrl string) (*listResponse, error) {
+	return c.cache.get(requestUrl, func() (*listResponse, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header = c.Headers
+		resp, err := c.HttpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		bodyBytes, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return &listResponse{
+			statusCode: resp.StatusCode,
+			status:     resp.Status,
+			body:       bodyBytes,
+		}, nil
+	})
+}
+
+// invalidateList drops cached list responses for the collection at
+// collectionUrl. It must be called after any request that modifies the
+// collection.
+func (c *OodleApiClient) invalidateList(collectionUrl string) {
+	c.cache.invalidate(collectionUrl)
+}
@@ -0,0 +1,138 @@
+package oodlehttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func newCachingTestModelClient(server *httptest.Server) *ModelClient[*clientmodels.Monitor] {
+	apiClient := newTestOodleAPIClient(server)
+	apiClient.cache = newResponseCache()
+	return NewModelClient[*clientmodels.Monitor](
+		apiClient,
+		"monitors",
+		func() *clientmodels.Monitor { return &clientmodels.Monitor{} },
+	)
+}
+
+func TestModelClientListIsCached(t *testing.T) {
+	var listCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			listCalls.Add(1)
+			_, _ = w.Write([]byte(`[{"name":"a"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"b"}`))
+	}))
+	defer server.Close()
+
+	client := newCachingTestModelClient(server)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.List(ctx); err != nil {
+			t.Fatalf("expected nil error, got: %v", err)
+		}
+	}
+	if got := listCalls.Load(); got != 1 {
+		t.Errorf("expected 1 list request, got %d", got)
+	}
+
+	if _, err := client.Create(ctx, &clientmodels.Monitor{Name: "b"}); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if _, err := client.List(ctx); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if got := listCalls.Load(); got != 2 {
+		t.Errorf("expected create to invalidate the cache, got %d list requests", got)
+	}
+}
+
+func TestModelClientListIsCoalesced(t *testing.T) {
+	var listCalls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listCalls.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`[{"name":"a"}]`))
+	}))
+	defer server.Close()
+
+	client := newCachingTestModelClient(server)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.List(context.Background())
+			errs <- err
+		}()
+	}
+
+	// Give every caller the chance to join the in-flight request.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("expected nil error, got: %v", err)
+		}
+	}
+	if got := listCalls.Load(); got != 1 {
+		t.Errorf("expected 1 list request, got %d", got)
+	}
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache()
+	cache.now = func() time.Time { return now }
+
+	fetches := 0
+	fetch := func() (*listResponse, error) {
+		fetches++
+		return &listResponse{statusCode: http.StatusOK}, nil
+	}
+
+	_, _ = cache.get("key", fetch)
+	_, _ = cache.get("key", fetch)
+	if fetches != 1 {
+		t.Errorf("expected 1 fetch before expiry, got %d", fetches)
+	}
+
+	now = now.Add(listCacheTTL)
+	_, _ = cache.get("key", fetch)
+	if fetches != 2 {
+		t.Errorf("expected 2 fetches after expiry, got %d", fetches)
+	}
+}
+
+func TestResponseCacheSkipsErrors(t *testing.T) {
+	cache := newResponseCache()
+
+	fetches := 0
+	fetch := func() (*listResponse, error) {
+		fetches++
+		return &listResponse{statusCode: http.StatusInternalServerError}, nil
+	}
+
+	_, _ = cache.get("key", fetch)
+	_, _ = cache.get("key", fetch)
+	if fetches != 2 {
+		t.Errorf("expected failed responses not to be cached, got %d fetches", fetches)
+	}
+}
@@ -0,0 +1,218 @@
+package oodlehttp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	tokenExchangeGrantType   = "urn:ietf:params:oauth:grant-type:token-exchange"
+	accessTokenType          = "urn:ietf:params:oauth:token-type:access_token"
+	DefaultSubjectTokenType  = "urn:ietf:params:oauth:token-type:jwt"
+	maxTokenResponseBodySize = 1 << 20
+)
+
+// ClientCredentialsConfig configures the OAuth2 client credentials grant.
+type ClientCredentialsConfig struct {
+	TokenUrl     string
+	ClientId     string
+	ClientSecret string
+	Scopes       []string
+	// Audience is sent as the audience parameter when set.
+	Audience string
+}
+
+// TokenExchangeConfig configures an OAuth2 token exchange (RFC 8693) that
+// trades a workload identity token, such as the OIDC token issued to a CI job
+// or a Kubernetes service account, for an Oodle access token.
+type TokenExchangeConfig struct {
+	TokenUrl string
+	Audience string
+	Scopes   []string
+	// ClientId is sent as the client_id parameter when set.
+	ClientId string
+	// SubjectTokenType defaults to DefaultSubjectTokenType.
+	SubjectTokenType string
+	// SubjectToken returns the workload identity token. It is called before
+	// every exchange so that rotated tokens are picked up.
+	SubjectToken func() (string, error)
+}
+
+// tokenContext returns the context token sources use to request tokens. It
+// outlives the request that configured the provider and sends token requests
+// through a dedicated connection pool.
+func tokenContext() context.Context {
+	return context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: newTransport(),
+	})
+}
+
+// NewClientCredentialsSource returns a token source that obtains access
+// tokens with the client credentials grant, requesting a new token whenever
+// the current one expires.
+func NewClientCredentialsSource(cfg ClientCredentialsConfig) oauth2.TokenSource {
+	ccConfig := &clientcredentials.Config{
+		ClientID:     cfg.ClientId,
+		ClientSecret: cfg.ClientSecret,
+		TokenURL:     cfg.TokenUrl,
+		Scopes:       cfg.Scopes,
+	}
+	if cfg.Audience != "" {
+		ccConfig.EndpointParams = url.Values{"audience": {cfg.Audience}}
+	}
+
+	return ccConfig.TokenSource(tokenContext())
+}
+
+// NewTokenExchangeSource returns a token source that exchanges the subject
+// token for an access token, exchanging it again whenever the access token
+// expires.
+func NewTokenExchangeSource(cfg TokenExchangeConfig) oauth2.TokenSource {
+	if cfg.SubjectTokenType == "" {
+		cfg.SubjectTokenType = DefaultSubjectTokenType
+	}
+
+	return oauth2.ReuseTokenSource(nil, &tokenExchangeSource{
+		ctx: tokenContext(),
+		cfg: cfg,
+	})
+}
+
+// SubjectTokenFromFile returns a SubjectToken function that reads the token
+// from path.
+func SubjectTokenFromFile(path string) func() (string, error) {
+	return func() (string, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read subject token: %v", err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+}
+
+// SubjectTokenFromEnv returns a SubjectToken function that reads the token
+// from the environment variable name.
+func SubjectTokenFromEnv(name string) func() (string, error) {
+	return func() (string, error) {
+		token := strings.TrimSpace(os.Getenv(name))
+		if token == "" {
+			return "", fmt.Errorf("subject token environment variable %v is not set", name)
+		}
+		return token, nil
+	}
+}
+
+type tokenExchangeSource struct {
+	ctx context.Context
+	cfg TokenExchangeConfig
+}
+
+type tokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (s *tokenExchangeSource) Token() (*oauth2.Token, error) {
+	subjectToken, err := s.cfg.SubjectToken()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrantType},
+		"requested_token_type": {accessTokenType},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {s.cfg.SubjectTokenType},
+	}
+	if s.cfg.Audience != "" {
+		form.Set("audience", s.cfg.Audience)
+	}
+	if len(s.cfg.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.cfg.Scopes, " "))
+	}
+	if s.cfg.ClientId != "" {
+		form.Set("client_id", s.cfg.ClientId)
+	}
+
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.cfg.TokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpClient, _ := s.ctx.Value(oauth2.HTTPClient).(*http.Client)
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseBodySize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to exchange token: %v, body: %v", resp.Status, string(bodyBytes))
+	}
+
+	var tokenResp tokenExchangeResponse
+	if err = jsoniter.Unmarshal(bodyBytes, &tokenResp); err != nil {
+		return nil, err
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token exchange response has no access_token")
+	}
+
+	token := &oauth2.Token{
+		AccessToken: tokenResp.AccessToken,
+		TokenType:   tokenResp.TokenType,
+	}
+	if tokenResp.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// tokenTransport authenticates every request that does not already carry an
+// API key with an access token from its source. The source refreshes the
+// token once it expires.
+type tokenTransport struct {
+	base   http.RoundTripper
+	source oauth2.TokenSource
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if hasApiKey(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	token, err := t.source.Token()
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request it was given, and the
+	// clients share their header maps between requests.
+	req = req.Clone(req.Context())
+	token.SetAuthHeader(req)
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,130 @@
+package oodlehttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTokenExchangeSource(t *testing.T) {
+	var exchanges int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]string{
+			"grant_type":           tokenExchangeGrantType,
+			"requested_token_type": accessTokenType,
+			"subject_token":        fmt.Sprintf("oidc-token-%d", exchanges+1),
+			"subject_token_type":   DefaultSubjectTokenType,
+			"audience":             "oodle",
+			"scope":                "read write",
+		}
+		for key, value := range want {
+			if got := r.PostForm.Get(key); got != value {
+				t.Errorf("expected %v=%q, got %q", key, value, got)
+			}
+		}
+
+		exchanges++
+		// A token that expires immediately forces an exchange per request.
+		_, _ = fmt.Fprintf(w, `{"access_token":"access-%d","token_type":"Bearer","expires_in":1}`, exchanges)
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	source := NewTokenExchangeSource(TokenExchangeConfig{
+		TokenUrl: server.URL,
+		Audience: "oodle",
+		Scopes:   []string{"read", "write"},
+		SubjectToken: func() (string, error) {
+			if err := os.WriteFile(tokenFile, []byte(fmt.Sprintf("oidc-token-%d\n", exchanges+1)), 0o600); err != nil {
+				return "", err
+			}
+			return SubjectTokenFromFile(tokenFile)()
+		},
+	})
+
+	for i := 1; i <= 2; i++ {
+		token, err := source.Token()
+		if err != nil {
+			t.Fatalf("expected nil error, got: %v", err)
+		}
+		if want := fmt.Sprintf("access-%d", i); token.AccessToken != want {
+			t.Errorf("expected token %q, got %q", want, token.AccessToken)
+		}
+	}
+}
+
+func TestTokenExchangeSourceErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewTokenExchangeSource(TokenExchangeConfig{
+		TokenUrl:     server.URL,
+		SubjectToken: func() (string, error) { return "oidc-token", nil },
+	}).Token()
+	if err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("expected error with the response body, got: %v", err)
+	}
+
+	_, err = NewTokenExchangeSource(TokenExchangeConfig{
+		TokenUrl:     server.URL,
+		SubjectToken: SubjectTokenFromEnv("OODLE_TEST_UNSET_SUBJECT_TOKEN"),
+	}).Token()
+	if err == nil || !strings.Contains(err.Error(), "OODLE_TEST_UNSET_SUBJECT_TOKEN") {
+		t.Errorf("expected error for missing subject token, got: %v", err)
+	}
+}
+
+func TestTokenClientSetsBearerToken(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm.Get("grant_type"); got != "client_credentials" {
+			t.Errorf("expected client_credentials grant, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	type auth struct{ bearer, apiKey string }
+	var got []auth
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, auth{r.Header.Get("Authorization"), r.Header.Get(OodleApiKeyHeader)})
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	source := NewClientCredentialsSource(ClientCredentialsConfig{
+		TokenUrl:     tokenServer.URL,
+		ClientId:     "ci",
+		ClientSecret: "secret",
+	})
+	client, err := NewTokenClient(server.URL, "prod", source, map[string]string{"staging": "staging-key"})
+	if err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, instance := range []string{"prod", "staging"} {
+		if _, err = client.ForInstance(instance).getList(ctx, server.URL+"/"+instance); err != nil {
+			t.Fatalf("expected nil error, got: %v", err)
+		}
+	}
+
+	want := []auth{{"Bearer access-token", ""}, {"", "staging-key"}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
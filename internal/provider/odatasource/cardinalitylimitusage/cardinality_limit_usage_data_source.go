@@ -0,0 +1,257 @@
+package cardinalitylimitusage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cardinalityLimitUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &cardinalityLimitUsageDataSource{}
+)
+
+type cardinalityLimitUsageDataSource struct {
+	client *oodlehttp.ModelClient[*clientmodels.CardinalityLimit]
+}
+
+type cardinalityLimitUsageDataSourceModel struct {
+	resourceutils.InstanceModel
+
+	ID        types.String      `tfsdk:"id"`
+	MaxSeries types.Int64       `tfsdk:"max_series"`
+	Usage     []limitUsageModel `tfsdk:"usage"`
+	Limits    []limitModel      `tfsdk:"limits"`
+}
+
+type limitModel struct {
+	ID        types.String      `tfsdk:"id"`
+	Name      types.String      `tfsdk:"name"`
+	MaxSeries types.Int64       `tfsdk:"max_series"`
+	Usage     []limitUsageModel `tfsdk:"usage"`
+}
+
+type limitUsageModel struct {
+	MetricName   types.String  `tfsdk:"metric_name"`
+	LabelValue   types.String  `tfsdk:"label_value"`
+	ActiveSeries types.Int64   `tfsdk:"active_series"`
+	UsagePercent types.Float64 `tfsdk:"usage_percent"`
+}
+
+func NewCardinalityLimitUsageDataSource() datasource.DataSource {
+	return &cardinalityLimitUsageDataSource{}
+}
+
+func (d *cardinalityLimitUsageDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cardinality_limit_usage"
+}
+
+func (d *cardinalityLimitUsageDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the active series counted against a cardinality limit, or against every " +
+			"cardinality limit when id is not set.",
+		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of the cardinality limit. When not set, the usage of every cardinality " +
+					"limit is read into limits.",
+			},
+			"max_series": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum number of active series of the limit. Null when id is not set.",
+			},
+			"usage": schema.ListNestedAttribute{
+				Computed: true,
+				Description: "Active series of each limited metric, and of each value of the per_label label " +
+					"when it is set. Null when id is not set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: usageAttributes(),
+				},
+			},
+			"limits": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Usage of every cardinality limit of the instance. Null when id is set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the cardinality limit.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the cardinality limit.",
+						},
+						"max_series": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The maximum number of active series of the limit.",
+						},
+						"usage": schema.ListNestedAttribute{
+							Computed: true,
+							Description: "Active series of each limited metric, and of each value of the per_label " +
+								"label when it is set.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: usageAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func usageAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"metric_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the metric.",
+		},
+		"label_value": schema.StringAttribute{
+			Computed:    true,
+			Description: "The value of the per_label label. Null when the limit is not applied per label.",
+		},
+		"active_series": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of series that are currently active.",
+		},
+		"usage_percent": schema.Float64Attribute{
+			Computed:    true,
+			Description: "The active series as a percentage of max_series.",
+		},
+	}
+}
+
+func (d *cardinalityLimitUsageDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *providerdata.Data, got: %T.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = oodlehttp.NewModelClient[*clientmodels.CardinalityLimit](
+		data.Client,
+		"cardinality-limits",
+		func() *clientmodels.CardinalityLimit { return &clientmodels.CardinalityLimit{} },
+	)
+}
+
+func (d *cardinalityLimitUsageDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config cardinalityLimitUsageDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client.ForInstance(config.Instance.ValueString())
+	state := cardinalityLimitUsageDataSourceModel{
+		InstanceModel: config.InstanceModel,
+		ID:            config.ID,
+		MaxSeries:     types.Int64Null(),
+	}
+	if !config.ID.IsNull() {
+		var usage clientmodels.CardinalityLimitUsage
+		err := client.GetSubresource(ctx, config.ID.ValueString(), "usage", &usage)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading cardinality limit usage",
+				fmt.Sprintf("Could not read usage of cardinality limit %v: %v", config.ID.ValueString(), err),
+			)
+			return
+		}
+		state.MaxSeries = types.Int64Value(usage.MaxSeries)
+		state.Usage = usageFromClientModel(&usage)
+	} else {
+		state.Limits = []limitModel{}
+		it := client.Iterate(ctx)
+		for it.Next() {
+			limit := it.Value()
+			var usage clientmodels.CardinalityLimitUsage
+			err := client.GetSubresource(ctx, limit.GetID(), "usage", &usage)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error reading cardinality limit usage",
+					fmt.Sprintf("Could not read usage of cardinality limit %v: %v", limit.GetID(), err),
+				)
+				return
+			}
+			state.Limits = append(state.Limits, limitFromClientModel(limit, &usage))
+		}
+		if err := it.Err(); err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing cardinality limits",
+				"Could not list cardinality limits: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func limitFromClientModel(limit *clientmodels.CardinalityLimit, usage *clientmodels.CardinalityLimitUsage) limitModel {
+	return limitModel{
+		ID:        types.StringValue(limit.ID),
+		Name:      types.StringValue(limit.Name),
+		MaxSeries: types.Int64Value(usage.MaxSeries),
+		Usage:     usageFromClientModel(usage),
+	}
+}
+
+func usageFromClientModel(usage *clientmodels.CardinalityLimitUsage) []limitUsageModel {
+	models := []limitUsageModel{}
+	for _, u := range usage.Usage {
+		m := limitUsageModel{
+			MetricName:   types.StringValue(u.MetricName),
+			LabelValue:   types.StringNull(),
+			ActiveSeries: types.Int64Value(u.ActiveSeries),
+			UsagePercent: types.Float64Null(),
+		}
+		if u.LabelValue != "" {
+			m.LabelValue = types.StringValue(u.LabelValue)
+		}
+		if usage.MaxSeries > 0 {
+			m.UsagePercent = types.Float64Value(float64(u.ActiveSeries) * 100 / float64(usage.MaxSeries))
+		}
+		models = append(models, m)
+	}
+	return models
+}
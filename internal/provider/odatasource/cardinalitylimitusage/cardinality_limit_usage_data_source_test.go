@@ -0,0 +1,68 @@
+package cardinalitylimitusage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestUsageFromClientModel(t *testing.T) {
+	usage := usageFromClientModel(&clientmodels.CardinalityLimitUsage{
+		MaxSeries: 1000,
+		Usage: []*clientmodels.CardinalityLimitSeries{
+			{MetricName: "http_requests_total", LabelValue: "checkout", ActiveSeries: 950},
+			{MetricName: "http_requests_total", LabelValue: "search", ActiveSeries: 125},
+		},
+	})
+
+	assert.Equal(t, len(usage), 2)
+	assert.Equal(t, usage[0].MetricName, types.StringValue("http_requests_total"))
+	assert.Equal(t, usage[0].LabelValue, types.StringValue("checkout"))
+	assert.Equal(t, usage[0].ActiveSeries, types.Int64Value(950))
+	assert.Equal(t, usage[0].UsagePercent.ValueFloat64(), 95.0)
+	assert.Equal(t, usage[1].UsagePercent.ValueFloat64(), 12.5)
+}
+
+func TestUsageFromClientModelNoPerLabel(t *testing.T) {
+	usage := usageFromClientModel(&clientmodels.CardinalityLimitUsage{
+		MaxSeries: 200,
+		Usage: []*clientmodels.CardinalityLimitSeries{
+			{MetricName: "kube_pod_info", ActiveSeries: 50},
+		},
+	})
+
+	assert.Equal(t, len(usage), 1)
+	assert.True(t, usage[0].LabelValue.IsNull())
+	assert.Equal(t, usage[0].UsagePercent.ValueFloat64(), 25.0)
+}
+
+func TestLimitFromClientModel(t *testing.T) {
+	limit := limitFromClientModel(
+		&clientmodels.CardinalityLimit{ID: "limit-1", Name: "requests per service", MaxSeries: 1000},
+		&clientmodels.CardinalityLimitUsage{
+			MaxSeries: 500,
+			Usage: []*clientmodels.CardinalityLimitSeries{
+				{MetricName: "http_requests_total", ActiveSeries: 400},
+			},
+		},
+	)
+
+	assert.Equal(t, limit.ID, types.StringValue("limit-1"))
+	assert.Equal(t, limit.Name, types.StringValue("requests per service"))
+	assert.Equal(t, limit.MaxSeries, types.Int64Value(500))
+	assert.Equal(t, len(limit.Usage), 1)
+	assert.Equal(t, limit.Usage[0].UsagePercent.ValueFloat64(), 80.0)
+}
+
+func TestLimitFromClientModelNoUsage(t *testing.T) {
+	limit := limitFromClientModel(
+		&clientmodels.CardinalityLimit{ID: "limit-2", Name: "idle"},
+		&clientmodels.CardinalityLimitUsage{MaxSeries: 100},
+	)
+
+	assert.Equal(t, limit.MaxSeries, types.Int64Value(100))
+	assert.DeepEqual(t, limit.Usage, []limitUsageModel{})
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -22,6 +25,8 @@ type grafanaDashboardsDataSource struct {
 }
 
 type grafanaDashboardsDataSourceModel struct {
+	resourceutils.InstanceModel
+
 	Dashboards []dashboardModel `tfsdk:"dashboards"`
 }
 
@@ -54,6 +59,7 @@ func (d *grafanaDashboardsDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Lists all Grafana dashboards.",
 		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
 			"dashboards": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of Grafana dashboards.",
@@ -99,39 +105,40 @@ func (d *grafanaDashboardsDataSource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
 		return
 	}
 
-	d.client = oodlehttp.NewGrafanaDashboardClient(client)
+	d.client = oodlehttp.NewGrafanaDashboardClient(data.Client)
 }
 
 func (d *grafanaDashboardsDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	dashboards, err := d.client.List(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing Grafana dashboards",
-			"Could not list Grafana dashboards: "+err.Error(),
-		)
+	var config grafanaDashboardsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := grafanaDashboardsDataSourceModel{
-		Dashboards: make([]dashboardModel, 0, len(dashboards)),
+		InstanceModel: config.InstanceModel,
+		Dashboards:    []dashboardModel{},
 	}
-	for _, db := range dashboards {
+	it := d.client.ForInstance(config.Instance.ValueString()).Iterate(ctx)
+	for it.Next() {
+		db := it.Value()
 		state.Dashboards = append(state.Dashboards, dashboardModel{
 			UID:         types.StringValue(db.UID),
 			Title:       types.StringValue(db.Title),
@@ -141,7 +148,14 @@ func (d *grafanaDashboardsDataSource) Read(
 			Type:        types.StringValue(db.Type),
 		})
 	}
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Grafana dashboards",
+			"Could not list Grafana dashboards: "+err.Error(),
+		)
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -22,6 +25,8 @@ type grafanaFoldersDataSource struct {
 }
 
 type grafanaFoldersDataSourceModel struct {
+	resourceutils.InstanceModel
+
 	Folders []folderModel `tfsdk:"folders"`
 }
 
@@ -50,6 +55,7 @@ func (d *grafanaFoldersDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Lists all Grafana folders.",
 		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
 			"folders": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of Grafana folders.",
@@ -79,27 +85,34 @@ func (d *grafanaFoldersDataSource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
 		return
 	}
 
-	d.client = oodlehttp.NewGrafanaFolderClient(client)
+	d.client = oodlehttp.NewGrafanaFolderClient(data.Client)
 }
 
 func (d *grafanaFoldersDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	folders, err := d.client.List(ctx)
+	var config grafanaFoldersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders, err := d.client.ForInstance(config.Instance.ValueString()).List(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error listing Grafana folders",
@@ -109,7 +122,8 @@ func (d *grafanaFoldersDataSource) Read(
 	}
 
 	state := grafanaFoldersDataSourceModel{
-		Folders: make([]folderModel, 0, len(folders)),
+		InstanceModel: config.InstanceModel,
+		Folders:       make([]folderModel, 0, len(folders)),
 	}
 	for _, f := range folders {
 		state.Folders = append(state.Folders, folderModel{
@@ -118,6 +132,6 @@ func (d *grafanaFoldersDataSource) Read(
 		})
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
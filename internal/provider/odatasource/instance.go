@@ -0,0 +1,14 @@
+package odatasource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// InstanceSchemaAttribute returns the schema of the optional instance
+// attribute shared by all data sources.
+func InstanceSchemaAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Oodle instance to read from. Defaults to the provider's instance.",
+	}
+}
@@ -10,6 +10,9 @@ import (
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,6 +26,8 @@ type logmetricsDataSource struct {
 }
 
 type logmetricsDataSourceModel struct {
+	resourceutils.InstanceModel
+
 	Logmetrics []logmetricsModel `tfsdk:"logmetrics"`
 }
 
@@ -51,6 +56,7 @@ func (d *logmetricsDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Lists all log metrics rules.",
 		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
 			"logmetrics": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of log metrics rules.",
@@ -80,12 +86,12 @@ func (d *logmetricsDataSource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
@@ -93,7 +99,7 @@ func (d *logmetricsDataSource) Configure(
 	}
 
 	d.client = oodlehttp.NewModelClient[*clientmodels.LogMetrics](
-		client,
+		data.Client,
 		"logmetrics",
 		func() *clientmodels.LogMetrics { return &clientmodels.LogMetrics{} },
 	)
@@ -101,28 +107,36 @@ func (d *logmetricsDataSource) Configure(
 
 func (d *logmetricsDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	logmetricsList, err := d.client.List(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing log metrics",
-			"Could not list log metrics: "+err.Error(),
-		)
+	var config logmetricsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := logmetricsDataSourceModel{
-		Logmetrics: make([]logmetricsModel, 0, len(logmetricsList)),
+		InstanceModel: config.InstanceModel,
+		Logmetrics:    []logmetricsModel{},
 	}
-	for _, l := range logmetricsList {
+	it := d.client.ForInstance(config.Instance.ValueString()).Iterate(ctx)
+	for it.Next() {
+		l := it.Value()
 		state.Logmetrics = append(state.Logmetrics, logmetricsModel{
 			ID:   types.StringValue(l.GetID()),
 			Name: types.StringValue(l.Name),
 		})
 	}
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing log metrics",
+			"Could not list log metrics: "+err.Error(),
+		)
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,232 @@
+package logmetricspreview
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	jsoniter "github.com/json-iterator/go"
+
+	"terraform-provider-oodle/internal/logfilter"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// definition is a log metrics definition written with the attribute names of
+// the oodle_logmetrics resource, so that the same object can be previewed and
+// deployed.
+type definition struct {
+	// ID and Instance are ignored. They are accepted so that an
+	// oodle_logmetrics resource can be passed as the definition.
+	ID       any `json:"id"`
+	Instance any `json:"instance"`
+
+	Name              string             `json:"name"`
+	Labels            []labelDefinition  `json:"labels"`
+	Filter            *filterDefinition  `json:"filter"`
+	FilterQuery       *string            `json:"filter_query"`
+	MetricDefinitions []metricDefinition `json:"metric_definitions"`
+
+	// MaxSeries only limits the series generated across records and is
+	// ignored.
+	MaxSeries any `json:"max_series"`
+}
+
+type labelDefinition struct {
+	Name           string                    `json:"name"`
+	Value          *string                   `json:"value"`
+	ValueExtractor *valueExtractorDefinition `json:"value_extractor"`
+}
+
+type valueExtractorDefinition struct {
+	Field    *string `json:"field"`
+	JSONPath *string `json:"json_path"`
+	Regex    *string `json:"regex"`
+}
+
+type matchDefinition struct {
+	Field    string  `json:"field"`
+	JSONPath *string `json:"json_path"`
+	Operator string  `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+type filterDefinition struct {
+	Match *matchDefinition    `json:"match"`
+	All   []*filterDefinition `json:"all"`
+	Any   []*filterDefinition `json:"any"`
+	Not   *filterDefinition   `json:"not"`
+}
+
+type metricDefinition struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Field    *string `json:"field"`
+	JSONPath *string `json:"json_path"`
+	Regex    *string `json:"regex"`
+
+	// Unit, Temporality, Buckets and Quantiles do not change the values
+	// emitted for a record and are ignored.
+	Unit        any `json:"unit"`
+	Temporality any `json:"temporality"`
+	Buckets     any `json:"buckets"`
+	Quantiles   any `json:"quantiles"`
+}
+
+// decodeDefinition converts a Terraform object shaped like the arguments of
+// the oodle_logmetrics resource to a client model.
+func decodeDefinition(value attr.Value) (*clientmodels.LogMetrics, error) {
+	raw, err := toJSONValue(value)
+	if err != nil {
+		return nil, err
+	}
+	data, err := jsoniter.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var def definition
+	if err := strictJSON.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("invalid definition: %v", err)
+	}
+
+	model := &clientmodels.LogMetrics{
+		Name: def.Name,
+	}
+	for _, l := range def.Labels {
+		label := &clientmodels.Label{
+			Name:  l.Name,
+			Value: l.Value,
+		}
+		if l.ValueExtractor != nil {
+			label.ValueExtractor = &clientmodels.ValueExtractor{
+				Field:    l.ValueExtractor.Field,
+				JSONPath: l.ValueExtractor.JSONPath,
+				Regex:    l.ValueExtractor.Regex,
+			}
+		}
+		model.Labels = append(model.Labels, label)
+	}
+
+	switch {
+	case def.Filter != nil && def.FilterQuery != nil:
+		return nil, fmt.Errorf("only one of filter and filter_query can be set")
+	case def.Filter != nil:
+		model.Filter = def.Filter.toClientModel()
+	case def.FilterQuery != nil:
+		model.Filter, err = logfilter.Parse(*def.FilterQuery)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter_query: %v", err)
+		}
+	}
+
+	for _, d := range def.MetricDefinitions {
+		metric := &clientmodels.MetricDefinition{
+			Name:     d.Name,
+			Type:     clientmodels.MetricType(d.Type),
+			JSONPath: d.JSONPath,
+			Regex:    d.Regex,
+		}
+		if d.Field != nil {
+			metric.Field = *d.Field
+		}
+		model.MetricDefinitions = append(model.MetricDefinitions, metric)
+	}
+	return model, nil
+}
+
+func (f *filterDefinition) toClientModel() *clientmodels.LogFilter {
+	if f == nil {
+		return nil
+	}
+
+	filter := &clientmodels.LogFilter{}
+	if f.Match != nil {
+		filter.Match = &clientmodels.Match{
+			Field:    f.Match.Field,
+			JSONPath: f.Match.JSONPath,
+			Operator: clientmodels.MatchOperator(f.Match.Operator),
+		}
+		if f.Match.Value != nil {
+			filter.Match.Value = *f.Match.Value
+		}
+	}
+	if len(f.All) > 0 {
+		filter.MatchAll = &clientmodels.MatchAll{}
+		for _, child := range f.All {
+			filter.MatchAll.All = append(filter.MatchAll.All, child.toClientModel())
+		}
+	}
+	if len(f.Any) > 0 {
+		filter.MatchAny = &clientmodels.MatchAny{}
+		for _, child := range f.Any {
+			filter.MatchAny.Any = append(filter.MatchAny.Any, child.toClientModel())
+		}
+	}
+	if f.Not != nil {
+		filter.MatchNot = &clientmodels.MatchNot{Not: f.Not.toClientModel()}
+	}
+	return filter
+}
+
+// strictJSON rejects unknown attributes, so that misspelt attributes are
+// reported instead of silently ignored.
+var strictJSON = jsoniter.Config{DisallowUnknownFields: true}.Froze()
+
+// toJSONValue converts a Terraform value to the equivalent plain Go value that
+// encoding/json would produce.
+func toJSONValue(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("definition must be known")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return toJSONValue(v.UnderlyingValue())
+	case basetypes.ObjectValue:
+		return toJSONMap(v.Attributes())
+	case basetypes.MapValue:
+		return toJSONMap(v.Elements())
+	case basetypes.ListValue:
+		return toJSONList(v.Elements())
+	case basetypes.SetValue:
+		return toJSONList(v.Elements())
+	case basetypes.TupleValue:
+		return toJSONList(v.Elements())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		f, _ := v.ValueBigFloat().Float64()
+		return f, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func toJSONMap(values map[string]attr.Value) (map[string]any, error) {
+	res := make(map[string]any, len(values))
+	for k, v := range values {
+		converted, err := toJSONValue(v)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = converted
+	}
+	return res, nil
+}
+
+func toJSONList(values []attr.Value) ([]any, error) {
+	res := make([]any, len(values))
+	for i, v := range values {
+		converted, err := toJSONValue(v)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = converted
+	}
+	return res, nil
+}
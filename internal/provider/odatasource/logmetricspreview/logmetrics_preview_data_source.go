@@ -0,0 +1,106 @@
+package logmetricspreview
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &logmetricsPreviewDataSource{}
+)
+
+type logmetricsPreviewDataSource struct{}
+
+type logmetricsPreviewDataSourceModel struct {
+	Definition types.Dynamic `tfsdk:"definition"`
+	Samples    []string      `tfsdk:"samples"`
+	Results    types.List    `tfsdk:"results"`
+}
+
+func NewLogmetricsPreviewDataSource() datasource.DataSource {
+	return &logmetricsPreviewDataSource{}
+}
+
+func (d *logmetricsPreviewDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_logmetrics_preview"
+}
+
+func (d *logmetricsPreviewDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates a log metrics definition against sample log records. " +
+			"The evaluation runs locally in the provider and does not call Oodle.",
+		Attributes: map[string]schema.Attribute{
+			"definition": schema.DynamicAttribute{
+				Required:    true,
+				Description: definitionDescription,
+			},
+			"samples": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: samplesDescription,
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: resultsDescription,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"matched": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the sample matched the filter.",
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Labels that would be added to the metrics, by label name. Empty if the sample did not match.",
+						},
+						"values": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.NumberType,
+							Description: "Value each metric definition would emit, by metric name. Metrics without a value for the sample are omitted.",
+						},
+						"errors": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Problems found while extracting values from the sample, e.g. a metric value that is not numeric.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *logmetricsPreviewDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config logmetricsPreviewDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, diags := preview(ctx, config.Definition, config.Samples)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Results = results
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,74 @@
+package logmetricspreview
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &logmetricsPreviewFunction{}
+)
+
+type logmetricsPreviewFunction struct{}
+
+func NewLogmetricsPreviewFunction() function.Function {
+	return &logmetricsPreviewFunction{}
+}
+
+func (f *logmetricsPreviewFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "logmetrics_preview"
+}
+
+func (f *logmetricsPreviewFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluates a log metrics definition against sample log records.",
+		Description: "Returns, for each sample, whether it matched the filter, the extracted label values " +
+			"and the value each metric definition would emit. The evaluation runs locally and does not call Oodle.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "definition",
+				Description: definitionDescription,
+			},
+			function.ListParameter{
+				Name:        "samples",
+				ElementType: types.StringType,
+				Description: samplesDescription,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: resultAttrTypes},
+		},
+	}
+}
+
+func (f *logmetricsPreviewFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var definition types.Dynamic
+	var samples []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &definition, &samples))
+	if resp.Error != nil {
+		return
+	}
+
+	results, diags := preview(ctx, definition, samples)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, results))
+}
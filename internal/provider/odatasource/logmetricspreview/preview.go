@@ -0,0 +1,108 @@
+package logmetricspreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/logeval"
+)
+
+// resultModel is the preview of a log metrics definition for one sample.
+type resultModel struct {
+	Matched types.Bool `tfsdk:"matched"`
+	Labels  types.Map  `tfsdk:"labels"`
+	Values  types.Map  `tfsdk:"values"`
+	Errors  types.List `tfsdk:"errors"`
+}
+
+var resultAttrTypes = map[string]attr.Type{
+	"matched": types.BoolType,
+	"labels":  types.MapType{ElemType: types.StringType},
+	"values":  types.MapType{ElemType: types.NumberType},
+	"errors":  types.ListType{ElemType: types.StringType},
+}
+
+const (
+	definitionDescription = "Log metrics definition to evaluate, written like the arguments of an " +
+		"`oodle_logmetrics` resource: `labels`, `filter` or `filter_query`, and `metric_definitions`."
+	samplesDescription = "Sample log records to evaluate the definition against. " +
+		"Each record is a JSON object encoded as a string, e.g. with `jsonencode`."
+	resultsDescription = "Result of evaluating the definition against each sample, in the order of samples. " +
+		"Samples that reach a regex the preview cannot evaluate have a null `matched` and the reason in `errors`."
+)
+
+// preview evaluates a log metrics definition against sample log records.
+func preview(ctx context.Context, definition attr.Value, samples []string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resultsType := types.ObjectType{AttrTypes: resultAttrTypes}
+
+	rule, err := decodeDefinition(definition)
+	if err != nil {
+		diags.AddError("Invalid log metrics definition", err.Error())
+		return types.ListNull(resultsType), diags
+	}
+
+	evaluator := logeval.NewEvaluator()
+	if err := evaluator.CheckLogMetrics(rule); err != nil {
+		diags.AddError("Invalid log metrics definition", err.Error())
+		return types.ListNull(resultsType), diags
+	}
+
+	results := make([]resultModel, len(samples))
+	for i, sample := range samples {
+		record, err := logeval.ParseRecord(sample)
+		if err != nil {
+			diags.AddError("Invalid sample", fmt.Sprintf("samples[%d]: %v", i, err))
+			return types.ListNull(resultsType), diags
+		}
+
+		result, err := evaluator.EvaluateLogMetrics(rule, record)
+		var unsupported *logeval.UnsupportedRegexError
+		if errors.As(err, &unsupported) {
+			// Oodle evaluates the regex, so the definition is valid, but the
+			// outcome for this sample is not known.
+			diags.AddWarning("Sample not evaluated", fmt.Sprintf("samples[%d]: %v", i, err))
+			results[i] = unevaluatedResult(err)
+			continue
+		}
+		if err != nil {
+			diags.AddError("Failed to evaluate sample", fmt.Sprintf("samples[%d]: %v", i, err))
+			return types.ListNull(resultsType), diags
+		}
+
+		var d diag.Diagnostics
+		results[i].Matched = types.BoolValue(result.Matched)
+		results[i].Labels, d = types.MapValueFrom(ctx, types.StringType, result.Labels)
+		diags.Append(d...)
+		results[i].Values, d = types.MapValueFrom(ctx, types.NumberType, result.Values)
+		diags.Append(d...)
+		errs := result.Errors
+		if errs == nil {
+			errs = []string{}
+		}
+		results[i].Errors, d = types.ListValueFrom(ctx, types.StringType, errs)
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return types.ListNull(resultsType), diags
+	}
+
+	list, d := types.ListValueFrom(ctx, resultsType, results)
+	diags.Append(d...)
+	return list, diags
+}
+
+// unevaluatedResult is the result of a sample that could not be evaluated.
+func unevaluatedResult(err error) resultModel {
+	return resultModel{
+		Matched: types.BoolNull(),
+		Labels:  types.MapNull(types.StringType),
+		Values:  types.MapNull(types.NumberType),
+		Errors:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue(err.Error())}),
+	}
+}
@@ -0,0 +1,181 @@
+package logmetricspreview
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func object(attrs map[string]attr.Value) types.Object {
+	attrTypes := map[string]attr.Type{}
+	for k, v := range attrs {
+		attrTypes[k] = v.Type(context.Background())
+	}
+	return types.ObjectValueMust(attrTypes, attrs)
+}
+
+func tuple(elems ...attr.Value) types.Tuple {
+	elemTypes := make([]attr.Type, len(elems))
+	for i, v := range elems {
+		elemTypes[i] = v.Type(context.Background())
+	}
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+func TestPreview(t *testing.T) {
+	ctx := context.Background()
+	definition := types.DynamicValue(object(map[string]attr.Value{
+		"id":   types.StringNull(),
+		"name": types.StringValue("checkout_errors"),
+		"labels": tuple(
+			object(map[string]attr.Value{
+				"name": types.StringValue("container"),
+				"value_extractor": object(map[string]attr.Value{
+					"field": types.StringValue("container_name"),
+				}),
+			}),
+		),
+		"filter": object(map[string]attr.Value{
+			"any": tuple(
+				object(map[string]attr.Value{
+					"match": object(map[string]attr.Value{
+						"field":    types.StringValue("level"),
+						"operator": types.StringValue("is"),
+						"value":    types.StringValue("error"),
+					}),
+				}),
+			),
+		}),
+		"metric_definitions": tuple(
+			object(map[string]attr.Value{
+				"name": types.StringValue("errors_total"),
+				"type": types.StringValue("log_count"),
+			}),
+			object(map[string]attr.Value{
+				"name":  types.StringValue("duration"),
+				"type":  types.StringValue("histogram"),
+				"field": types.StringValue("duration"),
+			}),
+		),
+	}))
+
+	results, diags := preview(ctx, definition, []string{
+		`{"level":"error","container_name":"api","duration":1.5}`,
+		`{"level":"info","container_name":"api","duration":2}`,
+	})
+	assert.False(t, diags.HasError())
+
+	var got []resultModel
+	assert.False(t, results.ElementsAs(ctx, &got, false).HasError())
+	assert.Equal(t, len(got), 2)
+
+	assert.True(t, got[0].Matched.ValueBool())
+	assert.True(t, got[0].Labels.Equal(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"container": types.StringValue("api"),
+	})))
+	assert.True(t, got[0].Values.Equal(types.MapValueMust(types.NumberType, map[string]attr.Value{
+		"errors_total": types.NumberValue(big.NewFloat(1)),
+		"duration":     types.NumberValue(big.NewFloat(1.5)),
+	})))
+
+	assert.False(t, got[1].Matched.ValueBool())
+	assert.Equal(t, len(got[1].Values.Elements()), 0)
+}
+
+func TestPreviewErrors(t *testing.T) {
+	ctx := context.Background()
+	logCount := tuple(object(map[string]attr.Value{
+		"name": types.StringValue("errors_total"),
+		"type": types.StringValue("log_count"),
+	}))
+
+	tests := []struct {
+		name       string
+		definition attr.Value
+		samples    []string
+	}{
+		{
+			name: "filter and filter_query",
+			definition: object(map[string]attr.Value{
+				"filter_query": types.StringValue("level:error"),
+				"filter": object(map[string]attr.Value{
+					"match": object(map[string]attr.Value{
+						"field":    types.StringValue("level"),
+						"operator": types.StringValue("exists"),
+					}),
+				}),
+				"metric_definitions": logCount,
+			}),
+		},
+		{
+			name: "invalid filter_query",
+			definition: object(map[string]attr.Value{
+				"filter_query":       types.StringValue("level:(error"),
+				"metric_definitions": logCount,
+			}),
+		},
+		{
+			name: "unknown attribute",
+			definition: object(map[string]attr.Value{
+				"filters":            types.StringValue("level:error"),
+				"metric_definitions": logCount,
+			}),
+		},
+		{
+			name: "invalid sample",
+			definition: object(map[string]attr.Value{
+				"metric_definitions": logCount,
+			}),
+			samples: []string{`["not", "an", "object"]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, diags := preview(ctx, tt.definition, tt.samples)
+			assert.True(t, diags.HasError())
+		})
+	}
+}
+
+func TestPreviewUnsupportedRegex(t *testing.T) {
+	ctx := context.Background()
+	// The u flag is valid in the Rust dialect, but Go cannot compile it.
+	definition := object(map[string]attr.Value{
+		"filter_query": types.StringValue("level:error"),
+		"labels": tuple(
+			object(map[string]attr.Value{
+				"name": types.StringValue("user"),
+				"value_extractor": object(map[string]attr.Value{
+					"field": types.StringValue("msg"),
+					"regex": types.StringValue(`(?u)user=(\w+)`),
+				}),
+			}),
+		),
+		"metric_definitions": tuple(object(map[string]attr.Value{
+			"name": types.StringValue("errors_total"),
+			"type": types.StringValue("log_count"),
+		})),
+	})
+
+	results, diags := preview(ctx, definition, []string{
+		`{"level":"error","msg":"user=alice"}`,
+		`{"level":"info","msg":"user=bob"}`,
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, diags.WarningsCount(), 1)
+
+	var got []resultModel
+	assert.False(t, results.ElementsAs(ctx, &got, false).HasError())
+	assert.Equal(t, len(got), 2)
+
+	// The first sample reaches the regex, the second one is filtered out
+	// before it.
+	assert.True(t, got[0].Matched.IsNull())
+	assert.Equal(t, len(got[0].Errors.Elements()), 1)
+	assert.False(t, got[1].Matched.ValueBool())
+}
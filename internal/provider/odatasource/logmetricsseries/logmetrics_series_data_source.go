@@ -0,0 +1,154 @@
+package logmetricsseries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &logmetricsSeriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &logmetricsSeriesDataSource{}
+)
+
+type logmetricsSeriesDataSource struct {
+	client *oodlehttp.ModelClient[*clientmodels.LogMetrics]
+}
+
+type logmetricsSeriesDataSourceModel struct {
+	resourceutils.InstanceModel
+
+	ID      types.String   `tfsdk:"id"`
+	Metrics []metricSeries `tfsdk:"metrics"`
+}
+
+type metricSeries struct {
+	Name         types.String `tfsdk:"name"`
+	ActiveSeries types.Int64  `tfsdk:"active_series"`
+}
+
+func NewLogmetricsSeriesDataSource() datasource.DataSource {
+	return &logmetricsSeriesDataSource{}
+}
+
+func (d *logmetricsSeriesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_logmetrics_series"
+}
+
+func (d *logmetricsSeriesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the number of active series of each metric generated by a log metrics rule.",
+		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the log metrics rule.",
+			},
+			"metrics": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Metrics generated by the rule.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the metric.",
+						},
+						"active_series": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of series of the metric that are currently active.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *logmetricsSeriesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *providerdata.Data, got: %T.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = oodlehttp.NewModelClient[*clientmodels.LogMetrics](
+		data.Client,
+		"logmetrics",
+		func() *clientmodels.LogMetrics { return &clientmodels.LogMetrics{} },
+	)
+}
+
+func (d *logmetricsSeriesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config logmetricsSeriesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var series clientmodels.LogMetricsSeries
+	err := d.client.ForInstance(config.Instance.ValueString()).GetSubresource(ctx, config.ID.ValueString(), "series", &series)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading log metrics series",
+			fmt.Sprintf("Could not read series of log metrics rule %v: %v", config.ID.ValueString(), err),
+		)
+		return
+	}
+
+	state := logmetricsSeriesDataSourceModel{
+		InstanceModel: config.InstanceModel,
+		ID:            config.ID,
+		Metrics:       metricSeriesFromClientModel(&series),
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func metricSeriesFromClientModel(series *clientmodels.LogMetricsSeries) []metricSeries {
+	metrics := []metricSeries{}
+	for _, m := range series.Metrics {
+		metrics = append(metrics, metricSeries{
+			Name:         types.StringValue(m.Name),
+			ActiveSeries: types.Int64Value(m.ActiveSeries),
+		})
+	}
+	return metrics
+}
@@ -0,0 +1,31 @@
+package logmetricsseries
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestMetricSeriesFromClientModel(t *testing.T) {
+	metrics := metricSeriesFromClientModel(&clientmodels.LogMetricsSeries{
+		Metrics: []*clientmodels.MetricSeries{
+			{Name: "checkout_errors_total", ActiveSeries: 42},
+			{Name: "checkout_latency_seconds", ActiveSeries: 7},
+		},
+	})
+
+	assert.DeepEqual(t, metrics, []metricSeries{
+		{Name: types.StringValue("checkout_errors_total"), ActiveSeries: types.Int64Value(42)},
+		{Name: types.StringValue("checkout_latency_seconds"), ActiveSeries: types.Int64Value(7)},
+	})
+}
+
+func TestMetricSeriesFromClientModelEmpty(t *testing.T) {
+	metrics := metricSeriesFromClientModel(&clientmodels.LogMetricsSeries{})
+
+	// No series yet is an empty list rather than null.
+	assert.DeepEqual(t, metrics, []metricSeries{})
+}
@@ -0,0 +1,206 @@
+package metricdropruleimpact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &metricDropRuleImpactDataSource{}
+	_ datasource.DataSourceWithConfigure      = &metricDropRuleImpactDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &metricDropRuleImpactDataSource{}
+)
+
+type metricDropRuleImpactDataSource struct {
+	client *oodlehttp.ModelClient[*clientmodels.MetricDropRule]
+}
+
+func NewMetricDropRuleImpactDataSource() datasource.DataSource {
+	return &metricDropRuleImpactDataSource{}
+}
+
+func (d *metricDropRuleImpactDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_metric_drop_rule_impact"
+}
+
+func (d *metricDropRuleImpactDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reports how many series and samples a metric drop rule drops, or would drop, so that its " +
+			"impact can be reviewed before the rule goes live. The rule is either an existing rule, e.g. one " +
+			"created with dry_run, or is described by the same matchers as the oodle_metric_drop_rule resource.",
+		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
+			"rule_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of an existing metric drop rule. Conflicts with selector and metric_name.",
+			},
+			"selector": schema.StringAttribute{
+				Optional:   true,
+				CustomType: validatorutils.NewMetricSelectorType(),
+				Description: "Series the rule drops, written as a PromQL series selector such as " +
+					"`http_requests_total{env=\"dev\"}`. Conflicts with rule_id and metric_name.",
+				Validators: []validator.String{
+					validatorutils.NewMetricSelectorValidator(),
+				},
+			},
+			"metric_name": schema.SingleNestedAttribute{
+				Optional:    true,
+				Attributes:  labelmatchermodel.DataSourceSchema(),
+				Description: "The __name__ label matcher that selects which metrics the rule drops. Conflicts with rule_id and selector.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: labelmatchermodel.DataSourceSchema(),
+				},
+				Description: "Optional additional label matchers that further restrict which series are dropped. " +
+					"Can only be used together with metric_name.",
+			},
+			"active_series": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of active series matched by the rule.",
+			},
+			"samples_per_second": schema.Float64Attribute{
+				Computed:    true,
+				Description: "The rate of samples of the matched series.",
+			},
+			"top_metrics": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The metrics with the most matched series, in decreasing order of active series.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the metric.",
+						},
+						"active_series": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of active series of the metric matched by the rule.",
+						},
+						"samples_per_second": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The rate of samples of the matched series of the metric.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *metricDropRuleImpactDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	// The matchers may hold unknown values, so they are only read as
+	// objects.
+	var ruleID types.String
+	var selector validatorutils.MetricSelectorValue
+	var metricName types.Object
+	var filters types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule_id"), &ruleID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("selector"), &selector)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric_name"), &metricName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filters"), &filters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateSelection(!ruleID.IsNull(), !selector.IsNull(), !metricName.IsNull(), !filters.IsNull()); err != nil {
+		resp.Diagnostics.AddError("Invalid metric drop rule impact", err.Error())
+	}
+}
+
+func (d *metricDropRuleImpactDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *providerdata.Data, got: %T.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = oodlehttp.NewModelClient[*clientmodels.MetricDropRule](
+		data.Client,
+		"drop-rules",
+		func() *clientmodels.MetricDropRule { return &clientmodels.MetricDropRule{} },
+	)
+}
+
+func (d *metricDropRuleImpactDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config metricDropRuleImpactDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client.ForInstance(config.Instance.ValueString())
+	var impact clientmodels.MetricDropRuleImpact
+	if !config.RuleID.IsNull() {
+		err := client.GetSubresource(ctx, config.RuleID.ValueString(), "impact", &impact)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading metric drop rule impact",
+				fmt.Sprintf("Could not read impact of metric drop rule %v: %v", config.RuleID.ValueString(), err),
+			)
+			return
+		}
+	} else {
+		rule, err := config.toClientModel()
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid metric drop rule impact", err.Error())
+			return
+		}
+		if err := client.PostAction(ctx, "impact", rule, &impact); err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading metric drop rule impact",
+				fmt.Sprintf("Could not evaluate impact of metric drop rule: %v", err),
+			)
+			return
+		}
+	}
+
+	config.fromClientModel(&impact)
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
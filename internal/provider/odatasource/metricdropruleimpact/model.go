@@ -0,0 +1,96 @@
+package metricdropruleimpact
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/metricselector"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+type metricDropRuleImpactDataSourceModel struct {
+	resourceutils.InstanceModel
+
+	RuleID           types.String                       `tfsdk:"rule_id"`
+	Selector         validatorutils.MetricSelectorValue `tfsdk:"selector"`
+	MetricName       *labelmatchermodel.Model           `tfsdk:"metric_name"`
+	Filters          []labelmatchermodel.Model          `tfsdk:"filters"`
+	ActiveSeries     types.Int64                        `tfsdk:"active_series"`
+	SamplesPerSecond types.Float64                      `tfsdk:"samples_per_second"`
+	TopMetrics       []impactMetricModel                `tfsdk:"top_metrics"`
+}
+
+type impactMetricModel struct {
+	Name             types.String  `tfsdk:"name"`
+	ActiveSeries     types.Int64   `tfsdk:"active_series"`
+	SamplesPerSecond types.Float64 `tfsdk:"samples_per_second"`
+}
+
+// validateSelection checks that the rule to evaluate is selected in exactly
+// one way. Unknown attributes count as set.
+func validateSelection(ruleID, selector, metricName, filters bool) error {
+	set := 0
+	for _, isSet := range []bool{ruleID, selector, metricName} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of rule_id, selector and metric_name must be set")
+	}
+	if filters && !metricName {
+		return fmt.Errorf("filters can only be set together with metric_name")
+	}
+	return nil
+}
+
+// toClientModel returns the drop rule described by the selector or the
+// matchers, in the observe state.
+func (m *metricDropRuleImpactDataSourceModel) toClientModel() (*clientmodels.MetricDropRule, error) {
+	rule := &clientmodels.MetricDropRule{
+		Type:  "series",
+		State: clientmodels.ObserveMetricDropRuleState,
+	}
+
+	if !m.Selector.IsNull() {
+		metricName, filters, err := metricselector.Parse(m.Selector.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse selector: %v", err)
+		}
+		rule.MetricName = &metricName
+		rule.Filters = filters
+		return rule, nil
+	}
+
+	if m.MetricName == nil {
+		return nil, fmt.Errorf("one of selector and metric_name must be set")
+	}
+	metricName, err := m.MetricName.ToClientModel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metric_name match type: %v", err)
+	}
+	rule.MetricName = &metricName
+
+	rule.Filters, err = labelmatchermodel.ToClientModels(m.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse filter match type: %v", err)
+	}
+	return rule, nil
+}
+
+func (m *metricDropRuleImpactDataSourceModel) fromClientModel(impact *clientmodels.MetricDropRuleImpact) {
+	m.ActiveSeries = types.Int64Value(impact.ActiveSeries)
+	m.SamplesPerSecond = types.Float64Value(impact.SamplesPerSecond)
+	m.TopMetrics = []impactMetricModel{}
+	for _, metric := range impact.TopMetrics {
+		m.TopMetrics = append(m.TopMetrics, impactMetricModel{
+			Name:             types.StringValue(metric.Name),
+			ActiveSeries:     types.Int64Value(metric.ActiveSeries),
+			SamplesPerSecond: types.Float64Value(metric.SamplesPerSecond),
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package metricdropruleimpact
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+func TestValidateSelection(t *testing.T) {
+	assert.Nil(t, validateSelection(true, false, false, false))
+	assert.Nil(t, validateSelection(false, true, false, false))
+	assert.Nil(t, validateSelection(false, false, true, true))
+
+	for _, err := range []error{
+		validateSelection(false, false, false, false),
+		validateSelection(true, true, false, false),
+		validateSelection(false, true, true, false),
+	} {
+		if err == nil {
+			t.Fatal("expected an error, got nil")
+		}
+		assert.Equal(t, err.Error(), "exactly one of rule_id, selector and metric_name must be set")
+	}
+
+	err := validateSelection(false, true, false, true)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	assert.Equal(t, err.Error(), "filters can only be set together with metric_name")
+}
+
+func TestToClientModel(t *testing.T) {
+	want := &clientmodels.MetricDropRule{
+		Type: "series",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchEqual,
+			Value: "http_requests_total",
+		},
+		Filters: []clientmodels.LabelMatcher{
+			{Name: "env", Type: amlabels.MatchEqual, Value: "dev"},
+		},
+		State: clientmodels.ObserveMetricDropRuleState,
+	}
+
+	fromSelector := &metricDropRuleImpactDataSourceModel{
+		Selector: validatorutils.NewMetricSelectorValue(`http_requests_total{env="dev"}`),
+	}
+	rule, err := fromSelector.toClientModel()
+	assert.Nil(t, err)
+	assert.DeepEqual(t, rule, want)
+
+	fromMatchers := &metricDropRuleImpactDataSourceModel{
+		Selector: validatorutils.NewMetricSelectorNull(),
+		MetricName: &labelmatchermodel.Model{
+			Name:  types.StringValue("__name__"),
+			Type:  types.StringValue("="),
+			Value: types.StringValue("http_requests_total"),
+		},
+		Filters: []labelmatchermodel.Model{
+			{Name: types.StringValue("env"), Type: types.StringValue("="), Value: types.StringValue("dev")},
+		},
+	}
+	rule, err = fromMatchers.toClientModel()
+	assert.Nil(t, err)
+	assert.DeepEqual(t, rule, want)
+}
+
+func TestFromClientModel(t *testing.T) {
+	m := &metricDropRuleImpactDataSourceModel{}
+	m.fromClientModel(&clientmodels.MetricDropRuleImpact{
+		ActiveSeries:     1200,
+		SamplesPerSecond: 80,
+		TopMetrics: []*clientmodels.MetricDropRuleImpactMetric{
+			{Name: "go_gc_duration_seconds", ActiveSeries: 1000, SamplesPerSecond: 66.5},
+			{Name: "go_gc_heap_goal_bytes", ActiveSeries: 200, SamplesPerSecond: 13.5},
+		},
+	})
+
+	assert.Equal(t, m.ActiveSeries, types.Int64Value(1200))
+	assert.Equal(t, m.SamplesPerSecond.ValueFloat64(), 80.0)
+	assert.Equal(t, len(m.TopMetrics), 2)
+	assert.Equal(t, m.TopMetrics[0].Name, types.StringValue("go_gc_duration_seconds"))
+	assert.Equal(t, m.TopMetrics[0].ActiveSeries, types.Int64Value(1000))
+	assert.Equal(t, m.TopMetrics[0].SamplesPerSecond.ValueFloat64(), 66.5)
+	assert.Equal(t, m.TopMetrics[1].Name, types.StringValue("go_gc_heap_goal_bytes"))
+}
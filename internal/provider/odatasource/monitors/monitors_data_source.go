@@ -10,6 +10,9 @@ import (
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,6 +26,8 @@ type monitorsDataSource struct {
 }
 
 type monitorsDataSourceModel struct {
+	resourceutils.InstanceModel
+
 	Monitors []monitorModel `tfsdk:"monitors"`
 }
 
@@ -51,6 +56,7 @@ func (d *monitorsDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Lists all monitors.",
 		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
 			"monitors": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of monitors.",
@@ -80,12 +86,12 @@ func (d *monitorsDataSource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
@@ -93,7 +99,7 @@ func (d *monitorsDataSource) Configure(
 	}
 
 	d.client = oodlehttp.NewModelClient[*clientmodels.Monitor](
-		client,
+		data.Client,
 		"monitors",
 		func() *clientmodels.Monitor { return &clientmodels.Monitor{} },
 	)
@@ -101,28 +107,36 @@ func (d *monitorsDataSource) Configure(
 
 func (d *monitorsDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	monitors, err := d.client.List(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing monitors",
-			"Could not list monitors: "+err.Error(),
-		)
+	var config monitorsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := monitorsDataSourceModel{
-		Monitors: make([]monitorModel, 0, len(monitors)),
+		InstanceModel: config.InstanceModel,
+		Monitors:      []monitorModel{},
 	}
-	for _, m := range monitors {
+	it := d.client.ForInstance(config.Instance.ValueString()).Iterate(ctx)
+	for it.Next() {
+		m := it.Value()
 		state.Monitors = append(state.Monitors, monitorModel{
 			ID:   types.StringValue(m.GetID()),
 			Name: types.StringValue(m.Name),
 		})
 	}
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing monitors",
+			"Could not list monitors: "+err.Error(),
+		)
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
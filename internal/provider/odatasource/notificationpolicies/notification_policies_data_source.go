@@ -10,6 +10,9 @@ import (
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,6 +26,8 @@ type notificationPoliciesDataSource struct {
 }
 
 type notificationPoliciesDataSourceModel struct {
+	resourceutils.InstanceModel
+
 	NotificationPolicies []notificationPolicyModel `tfsdk:"notification_policies"`
 }
 
@@ -51,6 +56,7 @@ func (d *notificationPoliciesDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Lists all notification policies.",
 		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
 			"notification_policies": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of notification policies.",
@@ -80,12 +86,12 @@ func (d *notificationPoliciesDataSource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
@@ -93,7 +99,7 @@ func (d *notificationPoliciesDataSource) Configure(
 	}
 
 	d.client = oodlehttp.NewModelClient[*clientmodels.NotificationPolicy](
-		client,
+		data.Client,
 		"notification-policies",
 		func() *clientmodels.NotificationPolicy { return &clientmodels.NotificationPolicy{} },
 	)
@@ -101,28 +107,36 @@ func (d *notificationPoliciesDataSource) Configure(
 
 func (d *notificationPoliciesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	policies, err := d.client.List(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing notification policies",
-			"Could not list notification policies: "+err.Error(),
-		)
+	var config notificationPoliciesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := notificationPoliciesDataSourceModel{
-		NotificationPolicies: make([]notificationPolicyModel, 0, len(policies)),
+		InstanceModel:        config.InstanceModel,
+		NotificationPolicies: []notificationPolicyModel{},
 	}
-	for _, p := range policies {
+	it := d.client.ForInstance(config.Instance.ValueString()).Iterate(ctx)
+	for it.Next() {
+		p := it.Value()
 		state.NotificationPolicies = append(state.NotificationPolicies, notificationPolicyModel{
 			ID:   types.StringValue(p.GetID()),
 			Name: types.StringValue(p.Name),
 		})
 	}
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing notification policies",
+			"Could not list notification policies: "+err.Error(),
+		)
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -10,6 +10,9 @@ import (
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -23,6 +26,8 @@ type notifiersDataSource struct {
 }
 
 type notifiersDataSourceModel struct {
+	resourceutils.InstanceModel
+
 	Notifiers []notifierModel `tfsdk:"notifiers"`
 }
 
@@ -52,6 +57,7 @@ func (d *notifiersDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Lists all notifiers.",
 		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
 			"notifiers": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of notifiers.",
@@ -85,12 +91,12 @@ func (d *notifiersDataSource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
@@ -98,7 +104,7 @@ func (d *notifiersDataSource) Configure(
 	}
 
 	d.client = oodlehttp.NewModelClient[*clientmodels.Notifier](
-		client,
+		data.Client,
 		"notifiers",
 		func() *clientmodels.Notifier { return &clientmodels.Notifier{} },
 	)
@@ -106,29 +112,37 @@ func (d *notifiersDataSource) Configure(
 
 func (d *notifiersDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	notifiersList, err := d.client.List(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error listing notifiers",
-			"Could not list notifiers: "+err.Error(),
-		)
+	var config notifiersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state := notifiersDataSourceModel{
-		Notifiers: make([]notifierModel, 0, len(notifiersList)),
+		InstanceModel: config.InstanceModel,
+		Notifiers:     []notifierModel{},
 	}
-	for _, n := range notifiersList {
+	it := d.client.ForInstance(config.Instance.ValueString()).Iterate(ctx)
+	for it.Next() {
+		n := it.Value()
 		state.Notifiers = append(state.Notifiers, notifierModel{
 			ID:   types.StringValue(n.GetID()),
 			Name: types.StringValue(n.Name),
 			Type: types.StringValue(n.Type.String()),
 		})
 	}
+	if err := it.Err(); err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing notifiers",
+			"Could not list notifiers: "+err.Error(),
+		)
+		return
+	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
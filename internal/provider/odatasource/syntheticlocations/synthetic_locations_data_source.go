@@ -0,0 +1,164 @@
+package syntheticlocations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/odatasource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &syntheticLocationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &syntheticLocationsDataSource{}
+)
+
+type syntheticLocationsDataSource struct {
+	client *oodlehttp.ModelClient[*clientmodels.SyntheticLocation]
+}
+
+type syntheticLocationsDataSourceModel struct {
+	resourceutils.InstanceModel
+
+	Locations []syntheticLocationModel `tfsdk:"locations"`
+}
+
+type syntheticLocationModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Cloud  types.String `tfsdk:"cloud"`
+	Region types.String `tfsdk:"region"`
+}
+
+func NewSyntheticLocationsDataSource() datasource.DataSource {
+	return &syntheticLocationsDataSource{}
+}
+
+func (d *syntheticLocationsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_synthetic_locations"
+}
+
+func (d *syntheticLocationsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the managed locations that synthetic monitors can run from.",
+		Attributes: map[string]schema.Attribute{
+			"instance": odatasource.InstanceSchemaAttribute(),
+			"locations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of managed locations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the location, used in the locations of oodle_synthetic_monitor resources.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the location.",
+						},
+						"cloud": schema.StringAttribute{
+							Computed:    true,
+							Description: "The cloud provider the location runs in (e.g. aws, gcp, azure).",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "The cloud region the location runs in (e.g. us-east-1).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *syntheticLocationsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerdata.Data)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *providerdata.Data, got: %T.",
+				req.ProviderData,
+			),
+		)
+		return
+	}
+
+	d.client = oodlehttp.NewModelClient[*clientmodels.SyntheticLocation](
+		data.Client,
+		"synthetic-locations",
+		func() *clientmodels.SyntheticLocation { return &clientmodels.SyntheticLocation{} },
+	)
+}
+
+func (d *syntheticLocationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config syntheticLocationsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locations, err := locationsFromIterator(d.client.ForInstance(config.Instance.ValueString()).Iterate(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing synthetic locations",
+			"Could not list synthetic locations: "+err.Error(),
+		)
+		return
+	}
+
+	state := syntheticLocationsDataSourceModel{
+		InstanceModel: config.InstanceModel,
+		Locations:     locations,
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func locationsFromIterator(
+	it *oodlehttp.ListIterator[*clientmodels.SyntheticLocation],
+) ([]syntheticLocationModel, error) {
+	locations := []syntheticLocationModel{}
+	for it.Next() {
+		l := it.Value()
+		locations = append(locations, syntheticLocationModel{
+			ID:     types.StringValue(l.GetID()),
+			Name:   types.StringValue(l.Name),
+			Cloud:  types.StringValue(l.Cloud),
+			Region: types.StringValue(l.Region),
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
@@ -0,0 +1,77 @@
+package syntheticlocations
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func newTestLocationsClient(t *testing.T, handler http.HandlerFunc) *oodlehttp.ModelClient[*clientmodels.SyntheticLocation] {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := oodlehttp.NewInstanceClient(server.URL, "test-instance", "test-key", nil)
+	assert.Nil(t, err)
+	return oodlehttp.NewModelClient[*clientmodels.SyntheticLocation](
+		client,
+		"synthetic-locations",
+		func() *clientmodels.SyntheticLocation { return &clientmodels.SyntheticLocation{} },
+	)
+}
+
+func TestLocationsFromIterator(t *testing.T) {
+	client := newTestLocationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, "/synthetic-locations"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": "aws:us-east-1", "name": "N. Virginia", "cloud": "aws", "region": "us-east-1"},
+			{"id": "gcp:europe-west1", "name": "Belgium", "cloud": "gcp", "region": "europe-west1"}
+		]`))
+	})
+
+	locations, err := locationsFromIterator(client.Iterate(context.Background()))
+	assert.Nil(t, err)
+	assert.DeepEqual(t, locations, []syntheticLocationModel{
+		{
+			ID:     types.StringValue("aws:us-east-1"),
+			Name:   types.StringValue("N. Virginia"),
+			Cloud:  types.StringValue("aws"),
+			Region: types.StringValue("us-east-1"),
+		},
+		{
+			ID:     types.StringValue("gcp:europe-west1"),
+			Name:   types.StringValue("Belgium"),
+			Cloud:  types.StringValue("gcp"),
+			Region: types.StringValue("europe-west1"),
+		},
+	})
+}
+
+func TestLocationsFromIteratorEmpty(t *testing.T) {
+	client := newTestLocationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	locations, err := locationsFromIterator(client.Iterate(context.Background()))
+	assert.Nil(t, err)
+	assert.DeepEqual(t, locations, []syntheticLocationModel{})
+}
+
+func TestLocationsFromIteratorError(t *testing.T) {
+	client := newTestLocationsClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	locations, err := locationsFromIterator(client.Iterate(context.Background()))
+	assert.True(t, err != nil)
+	assert.Nil(t, locations)
+}
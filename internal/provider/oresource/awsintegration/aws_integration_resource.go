@@ -69,6 +69,7 @@ func (r *awsIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"The IAM role must already exist with a trust policy that allows Oodle's AWS account to assume it under the given external ID; " +
 			"see the `oodle_aws_integration` module under examples/modules for a one-shot deploy that creates the role via CloudFormation.",
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the AWS integration assigned by Oodle.",
@@ -11,6 +11,8 @@ import (
 )
 
 type awsIntegrationResourceModel struct {
+	resourceutils.InstanceModel
+
 	ID                      types.String                  `tfsdk:"id"`
 	Name                    types.String                  `tfsdk:"name"`
 	Status                  types.String                  `tfsdk:"status"`
@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/providerdata"
 	"terraform-provider-oodle/internal/resourceutils"
 )
 
@@ -39,23 +39,33 @@ func (r *BaseResource[M, R]) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *oodlehttp.OodleApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = r.createClient(client)
+	r.client = r.createClient(data.Client)
+}
+
+// ModifyPlan requires the resource to be replaced when its instance changes.
+func (r *BaseResource[M, R]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var defaultInstance string
+	if r.client != nil {
+		defaultInstance = r.client.Instance
+	}
+	RequireReplaceOnInstanceChange(ctx, req, resp, defaultInstance)
 }
 
 func (r *BaseResource[M, R]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the instance, and save it
+	// to the id and instance attributes.
+	ImportStateWithInstance(ctx, req, resp)
 }
 
 // Create a new resource.
@@ -75,7 +85,7 @@ func (r *BaseResource[M, R]) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	createdObj, err := r.client.Create(ctx, clientModel)
+	createdObj, err := r.client.ForInstance(plan.GetInstance().ValueString()).Create(ctx, clientModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating model",
@@ -86,10 +96,12 @@ func (r *BaseResource[M, R]) Create(ctx context.Context, req resource.CreateRequ
 
 	// Update plan with newly created object.
 	newPlan := r.newResourceModel()
+	preserveConfigured(newPlan, plan)
 	newPlan.FromClientModel(ctx, createdObj, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newPlan.SetInstance(plan.GetInstance())
 
 	diags = resp.State.Set(ctx, newPlan)
 	resp.Diagnostics.Append(diags...)
@@ -113,7 +125,8 @@ func (r *BaseResource[M, R]) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	obj, err := r.client.Get(ctx, id.ValueString())
+	instance := state.GetInstance()
+	obj, err := r.client.ForInstance(instance.ValueString()).Get(ctx, id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading model",
@@ -122,10 +135,12 @@ func (r *BaseResource[M, R]) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	preserveConfigured(state, state)
 	state.FromClientModel(ctx, obj, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.SetInstance(instance)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -167,7 +182,7 @@ func (r *BaseResource[M, R]) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	updatedObj, err := r.client.Update(ctx, model)
+	updatedObj, err := r.client.ForInstance(plan.GetInstance().ValueString()).Update(ctx, model)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating model",
@@ -178,10 +193,12 @@ func (r *BaseResource[M, R]) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Update plan with newly created monitor.
 	newState := r.newResourceModel()
+	preserveConfigured(newState, plan)
 	newState.FromClientModel(ctx, updatedObj, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.SetInstance(plan.GetInstance())
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -205,7 +222,7 @@ func (r *BaseResource[M, R]) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.client.Delete(ctx, id.ValueString())
+	err := r.client.ForInstance(state.GetInstance().ValueString()).Delete(ctx, id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting model",
@@ -214,3 +231,11 @@ func (r *BaseResource[M, R]) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// preserveConfigured passes prior to models that keep attributes in the form
+// they were configured in.
+func preserveConfigured[R any](model R, prior R) {
+	if preserver, ok := any(model).(resourceutils.ConfiguredFormPreserver[R]); ok {
+		preserver.PreserveConfigured(prior)
+	}
+}
@@ -0,0 +1,127 @@
+package cardinalitylimit
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &cardinalityLimitResource{}
+	_ resource.ResourceWithConfigure        = &cardinalityLimitResource{}
+	_ resource.ResourceWithImportState      = &cardinalityLimitResource{}
+	_ resource.ResourceWithConfigValidators = &cardinalityLimitResource{}
+)
+
+const cardinalityLimitsResourcePath = "cardinality-limits"
+
+var validActions = map[string]struct{}{
+	string(clientmodels.DropCardinalityLimitAction):  {},
+	string(clientmodels.AlertCardinalityLimitAction): {},
+}
+
+// cardinalityLimitResource is the resource implementation.
+type cardinalityLimitResource struct {
+	oresource.BaseResource[*clientmodels.CardinalityLimit, *cardinalityLimitResourceModel]
+}
+
+func NewCardinalityLimitResource() resource.Resource {
+	modelCreator := func() *clientmodels.CardinalityLimit {
+		return &clientmodels.CardinalityLimit{}
+	}
+	return &cardinalityLimitResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.CardinalityLimit, *cardinalityLimitResourceModel](
+			func() *cardinalityLimitResourceModel {
+				return &cardinalityLimitResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.CardinalityLimit] {
+				return oodlehttp.NewModelClient[*clientmodels.CardinalityLimit](
+					oodleHttpClient,
+					cardinalityLimitsResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *cardinalityLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cardinality_limit"
+}
+
+// ConfigValidators returns the validators that need the whole configuration.
+func (r *cardinalityLimitResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewCardinalityLimitPerLabelValidator(),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *cardinalityLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a cardinality limit. Cardinality limits cap the number of active series of each " +
+			"metric they match, optionally per value of a label, to contain cardinality explosions.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the cardinality limit.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Human-readable name for the cardinality limit.",
+			},
+			"metric_name": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  labelmatchermodel.Schema(),
+				Description: "The __name__ label matcher that selects which metrics are limited.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: labelmatchermodel.Schema(),
+				},
+				Description: "Optional additional label matchers that further restrict which series are limited.",
+			},
+			"max_series": schema.Int64Attribute{
+				Required: true,
+				Description: "Maximum number of active series of each matched metric, or of each value of " +
+					"per_label when it is set. Must be at least 1.",
+				Validators: []validator.Int64{
+					validatorutils.NewInt64AtLeastValidator(1),
+				},
+			},
+			"per_label": schema.StringAttribute{
+				Optional: true,
+				Description: "Label whose values are limited separately, e.g. `service`, so that one service " +
+					"cannot use up the limit of the others.",
+				Validators: []validator.String{
+					validatorutils.NewLabelNameValidator(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  validatorutils.NewDefaultString(types.StringValue(string(clientmodels.DropCardinalityLimitAction))),
+				Description: "What happens to new series once the limit is reached. Possible values are:\n" +
+					"  - `drop` - New series are dropped and an alert is raised. This is the default.\n" +
+					"  - `alert` - An alert is raised, and new series are still ingested.",
+				Validators: []validator.String{
+					validatorutils.NewChoiceValidator(validActions),
+				},
+			},
+		},
+	}
+}
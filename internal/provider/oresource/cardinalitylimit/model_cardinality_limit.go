@@ -0,0 +1,85 @@
+package cardinalitylimit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type cardinalityLimitResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID         types.String              `tfsdk:"id"`
+	Name       types.String              `tfsdk:"name"`
+	MetricName *labelmatchermodel.Model  `tfsdk:"metric_name"`
+	Filters    []labelmatchermodel.Model `tfsdk:"filters"`
+	MaxSeries  types.Int64               `tfsdk:"max_series"`
+	PerLabel   types.String              `tfsdk:"per_label"`
+	Action     types.String              `tfsdk:"action"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.CardinalityLimit] = (*cardinalityLimitResourceModel)(nil)
+
+func (m *cardinalityLimitResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *cardinalityLimitResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *cardinalityLimitResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.CardinalityLimit,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data.
+	*m = cardinalityLimitResourceModel{}
+
+	m.ID = types.StringValue(model.ID)
+	m.Name = types.StringValue(model.Name)
+	if model.MetricName != nil {
+		metricName := labelmatchermodel.FromClientModel(*model.MetricName)
+		m.MetricName = &metricName
+	}
+	m.Filters = labelmatchermodel.FromClientModels(model.Filters)
+	m.MaxSeries = types.Int64Value(model.MaxSeries)
+	if model.PerLabel != "" {
+		m.PerLabel = types.StringValue(model.PerLabel)
+	}
+	m.Action = types.StringValue(string(model.Action))
+}
+
+func (m *cardinalityLimitResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.CardinalityLimit,
+) error {
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID = m.ID.ValueString()
+	}
+	model.Name = m.Name.ValueString()
+	if m.MetricName != nil {
+		metricName, err := m.MetricName.ToClientModel()
+		if err != nil {
+			return fmt.Errorf("failed to parse metric_name match type: %v", err)
+		}
+		model.MetricName = &metricName
+	}
+
+	filters, err := labelmatchermodel.ToClientModels(m.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to parse filter match type: %v", err)
+	}
+	model.Filters = filters
+
+	model.MaxSeries = m.MaxSeries.ValueInt64()
+	model.PerLabel = m.PerLabel.ValueString()
+	model.Action = clientmodels.CardinalityLimitAction(m.Action.ValueString())
+	return nil
+}
@@ -0,0 +1,71 @@
+package cardinalitylimit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestCardinalityLimitModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.CardinalityLimit{
+		ID:   "test-id-123",
+		Name: "Request metrics per service",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchRegexp,
+			Value: "http_.*",
+		},
+		Filters: []clientmodels.LabelMatcher{
+			{
+				Name:  "env",
+				Type:  amlabels.MatchEqual,
+				Value: "prod",
+			},
+		},
+		MaxSeries: 50000,
+		PerLabel:  "service",
+		Action:    clientmodels.DropCardinalityLimitAction,
+	}
+
+	resourceModel := &cardinalityLimitResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.CardinalityLimit{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestCardinalityLimitModelNoPerLabel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.CardinalityLimit{
+		ID:   "test-id-456",
+		Name: "Kube state metrics",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchRegexp,
+			Value: "kube_.*",
+		},
+		MaxSeries: 200000,
+		Action:    clientmodels.AlertCardinalityLimitAction,
+	}
+
+	resourceModel := &cardinalityLimitResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, resourceModel.PerLabel.IsNull())
+
+	newClientModel := &clientmodels.CardinalityLimit{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,6 +11,9 @@ import (
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &grafanaDashboardResource{}
 	_ resource.ResourceWithConfigure   = &grafanaDashboardResource{}
 	_ resource.ResourceWithImportState = &grafanaDashboardResource{}
+	_ resource.ResourceWithModifyPlan  = &grafanaDashboardResource{}
 )
 
 type grafanaDashboardResource struct {
@@ -26,6 +29,8 @@ type grafanaDashboardResource struct {
 }
 
 type grafanaDashboardResourceModel struct {
+	resourceutils.InstanceModel
+
 	ID         types.String `tfsdk:"id"`
 	UID        types.String `tfsdk:"uid"`
 	ConfigJSON types.String `tfsdk:"config_json"`
@@ -56,6 +61,7 @@ func (r *grafanaDashboardResource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Manages a Grafana dashboard.",
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The UID of the dashboard.",
@@ -103,19 +109,19 @@ func (r *grafanaDashboardResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
 		return
 	}
 
-	r.client = oodlehttp.NewGrafanaDashboardClient(client)
+	r.client = oodlehttp.NewGrafanaDashboardClient(data.Client)
 }
 
 func (r *grafanaDashboardResource) Create(
@@ -149,7 +155,7 @@ func (r *grafanaDashboardResource) Create(
 		Message:   plan.Message.ValueString(),
 	}
 
-	created, err := r.client.Create(ctx, dashboard)
+	created, err := r.client.ForInstance(plan.Instance.ValueString()).Create(ctx, dashboard)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating dashboard",
@@ -179,7 +185,7 @@ func (r *grafanaDashboardResource) Read(
 		return
 	}
 
-	dashboard, err := r.client.Get(ctx, state.ID.ValueString())
+	dashboard, err := r.client.ForInstance(state.Instance.ValueString()).Get(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading dashboard",
@@ -259,7 +265,7 @@ func (r *grafanaDashboardResource) Update(
 		Message:   plan.Message.ValueString(),
 	}
 
-	updated, err := r.client.Update(ctx, dashboard)
+	updated, err := r.client.ForInstance(plan.Instance.ValueString()).Update(ctx, dashboard)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating dashboard",
@@ -293,7 +299,7 @@ func (r *grafanaDashboardResource) Delete(
 		return
 	}
 
-	err := r.client.Delete(ctx, state.ID.ValueString())
+	err := r.client.ForInstance(state.Instance.ValueString()).Delete(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting dashboard",
@@ -312,5 +318,18 @@ func (r *grafanaDashboardResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	oresource.ImportStateWithInstance(ctx, req, resp)
+}
+
+// ModifyPlan requires the resource to be replaced when its instance changes.
+func (r *grafanaDashboardResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var defaultInstance string
+	if r.client != nil {
+		defaultInstance = r.client.Instance
+	}
+	oresource.RequireReplaceOnInstanceChange(ctx, req, resp, defaultInstance)
 }
@@ -4,13 +4,15 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/providerdata"
+	"terraform-provider-oodle/internal/resourceutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -18,6 +20,7 @@ var (
 	_ resource.Resource                = &grafanaFolderResource{}
 	_ resource.ResourceWithConfigure   = &grafanaFolderResource{}
 	_ resource.ResourceWithImportState = &grafanaFolderResource{}
+	_ resource.ResourceWithModifyPlan  = &grafanaFolderResource{}
 )
 
 type grafanaFolderResource struct {
@@ -25,6 +28,8 @@ type grafanaFolderResource struct {
 }
 
 type grafanaFolderResourceModel struct {
+	resourceutils.InstanceModel
+
 	ID        types.String `tfsdk:"id"`
 	UID       types.String `tfsdk:"uid"`
 	Title     types.String `tfsdk:"title"`
@@ -52,6 +57,7 @@ func (r *grafanaFolderResource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Manages a Grafana folder.",
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The UID of the folder (same as uid).",
@@ -88,19 +94,19 @@ func (r *grafanaFolderResource) Configure(
 		return
 	}
 
-	client, ok := req.ProviderData.(*oodlehttp.OodleApiClient)
+	data, ok := req.ProviderData.(*providerdata.Data)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			fmt.Sprintf(
-				"Expected *oodlehttp.OodleApiClient, got: %T.",
+				"Expected *providerdata.Data, got: %T.",
 				req.ProviderData,
 			),
 		)
 		return
 	}
 
-	r.client = oodlehttp.NewGrafanaFolderClient(client)
+	r.client = oodlehttp.NewGrafanaFolderClient(data.Client)
 }
 
 func (r *grafanaFolderResource) Create(
@@ -121,7 +127,7 @@ func (r *grafanaFolderResource) Create(
 		ParentUID: plan.ParentUID.ValueString(),
 	}
 
-	created, err := r.client.Create(ctx, folder)
+	created, err := r.client.ForInstance(plan.Instance.ValueString()).Create(ctx, folder)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating folder",
@@ -150,7 +156,7 @@ func (r *grafanaFolderResource) Read(
 		return
 	}
 
-	folder, err := r.client.Get(ctx, state.ID.ValueString())
+	folder, err := r.client.ForInstance(state.Instance.ValueString()).Get(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading folder",
@@ -201,7 +207,7 @@ func (r *grafanaFolderResource) Update(
 		Title: plan.Title.ValueString(),
 	}
 
-	updated, err := r.client.Update(ctx, folder)
+	updated, err := r.client.ForInstance(plan.Instance.ValueString()).Update(ctx, folder)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating folder",
@@ -234,7 +240,7 @@ func (r *grafanaFolderResource) Delete(
 		return
 	}
 
-	err := r.client.Delete(ctx, state.ID.ValueString())
+	err := r.client.ForInstance(state.Instance.ValueString()).Delete(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting folder",
@@ -253,5 +259,18 @@ func (r *grafanaFolderResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	oresource.ImportStateWithInstance(ctx, req, resp)
+}
+
+// ModifyPlan requires the resource to be replaced when its instance changes.
+func (r *grafanaFolderResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var defaultInstance string
+	if r.client != nil {
+		defaultInstance = r.client.Instance
+	}
+	oresource.RequireReplaceOnInstanceChange(ctx, req, resp, defaultInstance)
 }
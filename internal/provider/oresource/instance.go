@@ -0,0 +1,83 @@
+package oresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// InstanceSchemaAttribute returns the schema of the optional instance
+// attribute shared by all resources. Resources require replacement when it
+// changes through RequireReplaceOnInstanceChange.
+func InstanceSchemaAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: "Oodle instance that owns the resource. Defaults to the provider's instance. " +
+			"Changing the instance forces a new resource to be created.",
+	}
+}
+
+// RequireReplaceOnInstanceChange requires the resource to be replaced when
+// the instance that owns it changes. A null instance is resolved to
+// defaultInstance, the provider's instance, before comparing, so that
+// setting instance to the provider's instance, or leaving it out after
+// importing with "instance/id", does not replace the resource.
+//
+// The provider's instance is only known once the resource is configured,
+// which is why this runs from ModifyPlan rather than as a plan modifier of
+// the attribute.
+func RequireReplaceOnInstanceChange(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	defaultInstance string,
+) {
+	// Nothing is replaced when the resource is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateInstance, planInstance types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("instance"), &stateInstance)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance"), &planInstance)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	effective := func(instance types.String) string {
+		if instance.IsNull() {
+			return defaultInstance
+		}
+		return instance.ValueString()
+	}
+	if planInstance.IsUnknown() || effective(planInstance) != effective(stateInstance) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("instance"))
+	}
+}
+
+// ImportStateWithInstance imports a resource by its ID. The ID may be
+// prefixed with the instance owning the resource, as in "instance/id", to
+// import it from an instance other than the provider's default.
+func ImportStateWithInstance(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	instance, id, found := strings.Cut(req.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if instance == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form \"id\" or \"instance/id\", got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
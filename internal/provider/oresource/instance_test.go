@@ -0,0 +1,56 @@
+package oresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestRequireReplaceOnInstanceChange(t *testing.T) {
+	ctx := context.TODO()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"instance": InstanceSchemaAttribute(),
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"instance": tftypes.String}}
+	withInstance := func(instance any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"instance": tftypes.NewValue(tftypes.String, instance),
+		})
+	}
+	missing := tftypes.NewValue(objectType, nil)
+
+	requiresReplace := func(state, plan tftypes.Value) bool {
+		req := resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: s, Raw: state},
+			Plan:  tfsdk.Plan{Schema: s, Raw: plan},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		RequireReplaceOnInstanceChange(ctx, req, resp, "default")
+		assert.False(t, resp.Diagnostics.HasError())
+		return len(resp.RequiresReplace) > 0 && resp.RequiresReplace[0].Equal(path.Root("instance"))
+	}
+
+	// Creating or destroying the resource never replaces it.
+	assert.False(t, requiresReplace(missing, withInstance("other")))
+	assert.False(t, requiresReplace(withInstance("other"), missing))
+
+	// The effective instance does not change.
+	assert.False(t, requiresReplace(withInstance(nil), withInstance(nil)))
+	assert.False(t, requiresReplace(withInstance("other"), withInstance("other")))
+	assert.False(t, requiresReplace(withInstance(nil), withInstance("default")))
+	assert.False(t, requiresReplace(withInstance("default"), withInstance(nil)))
+
+	// The effective instance changes, or may change.
+	assert.True(t, requiresReplace(withInstance(nil), withInstance("other")))
+	assert.True(t, requiresReplace(withInstance("other"), withInstance(nil)))
+	assert.True(t, requiresReplace(withInstance("other"), withInstance("default")))
+	assert.True(t, requiresReplace(withInstance("default"), withInstance(tftypes.UnknownValue)))
+}
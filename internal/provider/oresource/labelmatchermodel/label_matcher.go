@@ -0,0 +1,141 @@
+// Package labelmatchermodel holds the Terraform schema and model of
+// clientmodels.LabelMatcher, shared by the resources that select metric
+// series.
+package labelmatchermodel
+
+import (
+	"fmt"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+var validMatchTypes = map[string]struct{}{
+	"=":  {},
+	"!=": {},
+	"=~": {},
+	"!~": {},
+}
+
+// Model is the Terraform model of a clientmodels.LabelMatcher.
+type Model struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+// Schema returns the attributes of a label matcher.
+func Schema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "Label name to match against.",
+		},
+		"type": schema.StringAttribute{
+			Required:    true,
+			Description: "Match type. Possible values are: '=' (exact), '!=' (not equal), '=~' (regex), '!~' (negative regex).",
+			Validators: []validator.String{
+				validatorutils.NewChoiceValidator(validMatchTypes),
+			},
+		},
+		"value": schema.StringAttribute{
+			Required:    true,
+			Description: "Value or pattern to match against.",
+		},
+	}
+}
+
+// DataSourceSchema returns the attributes of a label matcher in a data source.
+func DataSourceSchema() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"name": dschema.StringAttribute{
+			Required:    true,
+			Description: "Label name to match against.",
+		},
+		"type": dschema.StringAttribute{
+			Required:    true,
+			Description: "Match type. Possible values are: '=' (exact), '!=' (not equal), '=~' (regex), '!~' (negative regex).",
+			Validators: []validator.String{
+				validatorutils.NewChoiceValidator(validMatchTypes),
+			},
+		},
+		"value": dschema.StringAttribute{
+			Required:    true,
+			Description: "Value or pattern to match against.",
+		},
+	}
+}
+
+// FromClientModel converts a label matcher received from oodle APIs to a
+// Model.
+func FromClientModel(matcher clientmodels.LabelMatcher) Model {
+	return Model{
+		Name:  types.StringValue(matcher.Name),
+		Type:  types.StringValue(matcher.Type.String()),
+		Value: types.StringValue(matcher.Value),
+	}
+}
+
+// ToClientModel converts the Model to a label matcher to use in oodle APIs.
+func (m Model) ToClientModel() (clientmodels.LabelMatcher, error) {
+	matchType, err := parseMatchType(m.Type.ValueString())
+	if err != nil {
+		return clientmodels.LabelMatcher{}, err
+	}
+	return clientmodels.LabelMatcher{
+		Name:  m.Name.ValueString(),
+		Type:  matchType,
+		Value: m.Value.ValueString(),
+	}, nil
+}
+
+// FromClientModels converts a list of label matchers. An empty list is
+// converted to nil, as optional lists are null when not configured.
+func FromClientModels(matchers []clientmodels.LabelMatcher) []Model {
+	if len(matchers) == 0 {
+		return nil
+	}
+	res := make([]Model, len(matchers))
+	for i, matcher := range matchers {
+		res[i] = FromClientModel(matcher)
+	}
+	return res
+}
+
+// ToClientModels converts a list of Models.
+func ToClientModels(models []Model) ([]clientmodels.LabelMatcher, error) {
+	if len(models) == 0 {
+		return nil, nil
+	}
+	res := make([]clientmodels.LabelMatcher, len(models))
+	for i, m := range models {
+		matcher, err := m.ToClientModel()
+		if err != nil {
+			return nil, err
+		}
+		res[i] = matcher
+	}
+	return res, nil
+}
+
+// parseMatchType parses the string form of a match type, e.g. "=~".
+func parseMatchType(s string) (amlabels.MatchType, error) {
+	switch s {
+	case "=":
+		return amlabels.MatchEqual, nil
+	case "!=":
+		return amlabels.MatchNotEqual, nil
+	case "=~":
+		return amlabels.MatchRegexp, nil
+	case "!~":
+		return amlabels.MatchNotRegexp, nil
+	default:
+		return 0, fmt.Errorf("invalid match type: %s", s)
+	}
+}
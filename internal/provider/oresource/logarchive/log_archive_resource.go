@@ -0,0 +1,154 @@
+package logarchive
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &logArchiveResource{}
+	_ resource.ResourceWithConfigure        = &logArchiveResource{}
+	_ resource.ResourceWithImportState      = &logArchiveResource{}
+	_ resource.ResourceWithConfigValidators = &logArchiveResource{}
+)
+
+const logArchivesResourcePath = "log-archives"
+
+var (
+	bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	endpointPattern   = regexp.MustCompile(`^https?://[^/\s]+(/\S*)?$`)
+	roleArnPattern    = regexp.MustCompile(`^arn:aws:iam::\d{12}:role/.+$`)
+)
+
+// logArchiveResource is the resource implementation.
+type logArchiveResource struct {
+	oresource.BaseResource[*clientmodels.LogArchive, *logArchiveResourceModel]
+}
+
+func NewLogArchiveResource() resource.Resource {
+	modelCreator := func() *clientmodels.LogArchive {
+		return &clientmodels.LogArchive{}
+	}
+	return &logArchiveResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.LogArchive, *logArchiveResourceModel](
+			func() *logArchiveResourceModel {
+				return &logArchiveResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.LogArchive] {
+				return oodlehttp.NewModelClient[*clientmodels.LogArchive](
+					oodleHttpClient,
+					logArchivesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *logArchiveResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_archive"
+}
+
+// ConfigValidators returns the validators that need the whole configuration.
+func (r *logArchiveResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewLogArchiveCredentialsValidator(),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *logArchiveResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a log archive. Archives copy logs to object storage, e.g. for compliance, " +
+			"independently of how long they are retained in Oodle.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the log archive.",
+				Validators: []validator.String{
+					validatorutils.NewUUIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the log archive.",
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional:    true,
+				Attributes:  logfiltermodel.Schema(),
+				Description: "Filter to select the logs to archive. Defaults to all logs.",
+				Validators: []validator.Object{
+					validatorutils.NewFilterValidator(),
+				},
+			},
+			"s3": schema.SingleNestedAttribute{
+				Required: true,
+				Description: "S3 or S3-compatible bucket to archive logs to. Oodle writes with the IAM role in role_arn, " +
+					"or with access_key_id and secret_access_key for stores that do not support IAM roles.",
+				Attributes: map[string]schema.Attribute{
+					"bucket": schema.StringAttribute{
+						Required:    true,
+						Description: "Name of the bucket.",
+						Validators: []validator.String{
+							validatorutils.NewRegexValidator(bucketNamePattern, "must be a valid bucket name"),
+						},
+					},
+					"prefix": schema.StringAttribute{
+						Optional:    true,
+						Description: "Prefix of the keys of the archived objects, e.g. `audit/`.",
+					},
+					"region": schema.StringAttribute{
+						Optional:    true,
+						Description: "Region of the bucket.",
+					},
+					"endpoint": schema.StringAttribute{
+						Optional: true,
+						Description: "URL of the S3 API to use instead of AWS, for S3-compatible stores such as MinIO, " +
+							"e.g. `https://minio.example.com:9000`.",
+						Validators: []validator.String{
+							validatorutils.NewRegexValidator(endpointPattern, "must be an http or https URL"),
+						},
+					},
+					"force_path_style": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Description: "Whether to address the bucket in the path of the URL instead of the host name, " +
+							"as most S3-compatible stores require. Defaults to false.",
+						Default: booldefault.StaticBool(false),
+					},
+					"role_arn": schema.StringAttribute{
+						Optional:    true,
+						Description: "ARN of the IAM role Oodle assumes to write to the bucket.",
+						Validators: []validator.String{
+							validatorutils.NewRegexValidator(roleArnPattern, "must be an IAM role ARN (arn:aws:iam::<account-id>:role/<role-name>)"),
+						},
+					},
+					"access_key_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "Access key ID to write to the bucket. Must be set together with secret_access_key.",
+					},
+					"secret_access_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Secret access key to write to the bucket. Must be set together with access_key_id.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package logarchive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type logArchiveResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID     types.String          `tfsdk:"id"`
+	Name   types.String          `tfsdk:"name"`
+	Filter *logfiltermodel.Model `tfsdk:"filter"`
+	S3     *s3DestinationModel   `tfsdk:"s3"`
+}
+
+type s3DestinationModel struct {
+	Bucket          types.String `tfsdk:"bucket"`
+	Prefix          types.String `tfsdk:"prefix"`
+	Region          types.String `tfsdk:"region"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	ForcePathStyle  types.Bool   `tfsdk:"force_path_style"`
+	RoleArn         types.String `tfsdk:"role_arn"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.LogArchive] = (*logArchiveResourceModel)(nil)
+
+func (m *logArchiveResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *logArchiveResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *logArchiveResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.LogArchive,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data
+	*m = logArchiveResourceModel{}
+
+	m.ID = types.StringValue(model.ID.UUID.String())
+	m.Name = types.StringValue(model.Name)
+	if model.Filter != nil {
+		m.Filter = logfiltermodel.FromClientModel(model.Filter)
+	}
+	if s3 := model.S3; s3 != nil {
+		m.S3 = &s3DestinationModel{
+			Bucket:          types.StringValue(s3.Bucket),
+			Prefix:          optionalString(s3.Prefix),
+			Region:          optionalString(s3.Region),
+			Endpoint:        optionalString(s3.Endpoint),
+			ForcePathStyle:  types.BoolValue(s3.ForcePathStyle),
+			RoleArn:         optionalString(s3.RoleArn),
+			AccessKeyID:     optionalString(s3.AccessKeyID),
+			SecretAccessKey: optionalString(s3.SecretAccessKey),
+		}
+	}
+}
+
+func (m *logArchiveResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.LogArchive,
+) error {
+	var err error
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID.UUID, err = uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse ID UUID %v: %v", m.ID.ValueString(), err)
+		}
+	}
+
+	model.Name = m.Name.ValueString()
+	if m.Filter != nil {
+		model.Filter = m.Filter.ToClientModel()
+	}
+	if s3 := m.S3; s3 != nil {
+		model.S3 = &clientmodels.LogArchiveS3Destination{
+			Bucket:          s3.Bucket.ValueString(),
+			Prefix:          s3.Prefix.ValueString(),
+			Region:          s3.Region.ValueString(),
+			Endpoint:        s3.Endpoint.ValueString(),
+			ForcePathStyle:  s3.ForcePathStyle.ValueBool(),
+			RoleArn:         s3.RoleArn.ValueString(),
+			AccessKeyID:     s3.AccessKeyID.ValueString(),
+			SecretAccessKey: s3.SecretAccessKey.ValueString(),
+		}
+	}
+	return nil
+}
+
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
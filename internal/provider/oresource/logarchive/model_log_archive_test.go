@@ -0,0 +1,70 @@
+package logarchive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestLogArchiveModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogArchive{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "audit archive",
+		Filter: &clientmodels.LogFilter{
+			Match: &clientmodels.Match{
+				Field:    "log_type",
+				Operator: clientmodels.IsOperator,
+				Value:    "audit",
+			},
+		},
+		S3: &clientmodels.LogArchiveS3Destination{
+			Bucket:  "acme-log-archive",
+			Prefix:  "audit/",
+			Region:  "us-west-2",
+			RoleArn: "arn:aws:iam::123456789012:role/oodle-archive",
+		},
+	}
+
+	resourceModel := &logArchiveResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, resourceModel.S3.Endpoint.IsNull())
+	assert.False(t, resourceModel.S3.ForcePathStyle.ValueBool())
+
+	newClientModel := &clientmodels.LogArchive{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestLogArchiveModelS3Compatible(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogArchive{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "minio archive",
+		S3: &clientmodels.LogArchiveS3Destination{
+			Bucket:          "logs",
+			Endpoint:        "https://minio.example.com:9000",
+			ForcePathStyle:  true,
+			AccessKeyID:     "minio",
+			SecretAccessKey: "minio-secret",
+		},
+	}
+
+	resourceModel := &logArchiveResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.LogArchive{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
@@ -0,0 +1,120 @@
+package logdroprule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &logDropRuleResource{}
+	_ resource.ResourceWithConfigure        = &logDropRuleResource{}
+	_ resource.ResourceWithImportState      = &logDropRuleResource{}
+	_ resource.ResourceWithConfigValidators = &logDropRuleResource{}
+)
+
+const logDropRulesResourcePath = "log-drop-rules"
+
+var validActions = map[string]struct{}{
+	string(clientmodels.DropLogDropAction):   {},
+	string(clientmodels.SampleLogDropAction): {},
+}
+
+// logDropRuleResource is the resource implementation.
+type logDropRuleResource struct {
+	oresource.BaseResource[*clientmodels.LogDropRule, *logDropRuleResourceModel]
+}
+
+func NewLogDropRuleResource() resource.Resource {
+	modelCreator := func() *clientmodels.LogDropRule {
+		return &clientmodels.LogDropRule{}
+	}
+	return &logDropRuleResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.LogDropRule, *logDropRuleResourceModel](
+			func() *logDropRuleResourceModel {
+				return &logDropRuleResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.LogDropRule] {
+				return oodlehttp.NewModelClient[*clientmodels.LogDropRule](
+					oodleHttpClient,
+					logDropRulesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *logDropRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_drop_rule"
+}
+
+// ConfigValidators returns the validators that need the whole configuration.
+func (r *logDropRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewLogDropRuleSamplingValidator(),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *logDropRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a log drop rule. Drop rules drop or sample noisy logs before they are ingested into Oodle.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the log drop rule.",
+				Validators: []validator.String{
+					validatorutils.NewUUIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the log drop rule.",
+			},
+			"filter": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  logfiltermodel.Schema(),
+				Description: "Filter to select the logs the rule applies to.",
+				Validators: []validator.Object{
+					validatorutils.NewFilterValidator(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required: true,
+				Description: "What to do with matching logs. Possible values are:\n" +
+					"  - `drop` - Drops all matching logs.\n" +
+					"  - `sample` - Keeps `sample_percentage` percent of matching logs.",
+				Validators: []validator.String{
+					validatorutils.NewChoiceValidator(validActions),
+				},
+			},
+			"sample_percentage": schema.Float64Attribute{
+				Optional: true,
+				Description: "Percentage of matching logs to keep, greater than 0 and less than 100. " +
+					"Required when action is 'sample' and not used otherwise.",
+				Validators: []validator.Float64{
+					validatorutils.NewFloat64ExclusiveRangeValidator(0, 100),
+				},
+			},
+			"sample_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Field whose value decides whether a log is kept, e.g. `trace_id`, so that all logs " +
+					"with the same value are kept or dropped together. Defaults to sampling each log independently. " +
+					"Only used when action is 'sample'.",
+			},
+		},
+	}
+}
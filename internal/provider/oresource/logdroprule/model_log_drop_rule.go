@@ -0,0 +1,78 @@
+package logdroprule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type logDropRuleResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID               types.String          `tfsdk:"id"`
+	Name             types.String          `tfsdk:"name"`
+	Filter           *logfiltermodel.Model `tfsdk:"filter"`
+	Action           types.String          `tfsdk:"action"`
+	SamplePercentage types.Float64         `tfsdk:"sample_percentage"`
+	SampleKey        types.String          `tfsdk:"sample_key"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.LogDropRule] = (*logDropRuleResourceModel)(nil)
+
+func (m *logDropRuleResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *logDropRuleResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *logDropRuleResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.LogDropRule,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data
+	*m = logDropRuleResourceModel{}
+
+	m.ID = types.StringValue(model.ID.UUID.String())
+	m.Name = types.StringValue(model.Name)
+	if model.Filter != nil {
+		m.Filter = logfiltermodel.FromClientModel(model.Filter)
+	}
+	m.Action = types.StringValue(string(model.Action))
+	if model.SamplePercentage != 0 {
+		m.SamplePercentage = types.Float64Value(model.SamplePercentage)
+	}
+	if model.SampleKey != "" {
+		m.SampleKey = types.StringValue(model.SampleKey)
+	}
+}
+
+func (m *logDropRuleResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.LogDropRule,
+) error {
+	var err error
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID.UUID, err = uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse ID UUID %v: %v", m.ID.ValueString(), err)
+		}
+	}
+	model.Name = m.Name.ValueString()
+	if m.Filter != nil {
+		model.Filter = m.Filter.ToClientModel()
+	}
+	model.Action = clientmodels.LogDropAction(m.Action.ValueString())
+	model.SamplePercentage = m.SamplePercentage.ValueFloat64()
+	model.SampleKey = m.SampleKey.ValueString()
+	return nil
+}
@@ -0,0 +1,85 @@
+package logdroprule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestLogDropRuleModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogDropRule{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "sample debug logs",
+		Filter: &clientmodels.LogFilter{
+			MatchAll: &clientmodels.MatchAll{
+				All: []*clientmodels.LogFilter{
+					{
+						Match: &clientmodels.Match{
+							Field:    "level",
+							Operator: clientmodels.IsOperator,
+							Value:    "debug",
+						},
+					},
+					{
+						MatchNot: &clientmodels.MatchNot{
+							Not: &clientmodels.LogFilter{
+								Match: &clientmodels.Match{
+									Field:    "service",
+									Operator: clientmodels.IsOperator,
+									Value:    "checkout",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Action:           clientmodels.SampleLogDropAction,
+		SamplePercentage: 12.5,
+		SampleKey:        "trace_id",
+	}
+
+	resourceModel := &logDropRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.LogDropRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestLogDropRuleModelDrop(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogDropRule{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "drop healthchecks",
+		Filter: &clientmodels.LogFilter{
+			Match: &clientmodels.Match{
+				Field:    "path",
+				Operator: clientmodels.IsOperator,
+				Value:    "/healthz",
+			},
+		},
+		Action: clientmodels.DropLogDropAction,
+	}
+
+	resourceModel := &logDropRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, resourceModel.SamplePercentage.IsNull())
+	assert.True(t, resourceModel.SampleKey.IsNull())
+
+	newClientModel := &clientmodels.LogDropRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
@@ -0,0 +1,218 @@
+package logfiltermodel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+type matchModel struct {
+	Field    types.String `tfsdk:"field"`
+	JSONPath types.String `tfsdk:"json_path"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type allNestedFilterModel struct {
+	Match *matchModel           `tfsdk:"match"`
+	Not   *notNestedFilterModel `tfsdk:"not"`
+}
+
+type anyNestedFilterModel struct {
+	Match *matchModel            `tfsdk:"match"`
+	Not   *notNestedFilterModel  `tfsdk:"not"`
+	All   []allNestedFilterModel `tfsdk:"all"`
+}
+
+type notNestedFilterModel struct {
+	Match *matchModel `tfsdk:"match"`
+}
+
+// Model is the Terraform model of a clientmodels.LogFilter. Nesting is
+// limited to what the schema returned by Schema allows.
+type Model struct {
+	Match *matchModel            `tfsdk:"match"`
+	All   []allNestedFilterModel `tfsdk:"all"`
+	Any   []anyNestedFilterModel `tfsdk:"any"`
+	Not   *notNestedFilterModel  `tfsdk:"not"`
+}
+
+// FromClientModel converts a log filter received from oodle APIs to a Model.
+func FromClientModel(filter *clientmodels.LogFilter) *Model {
+	res := &Model{}
+	if filter.Match != nil {
+		res.Match = fromClientModelMatch(filter.Match)
+	}
+	if filter.MatchAll != nil && len(filter.MatchAll.All) > 0 {
+		res.All = make([]allNestedFilterModel, len(filter.MatchAll.All))
+		for i, allElem := range filter.MatchAll.All {
+			if allElem.Match != nil {
+				res.All[i] = allNestedFilterModel{
+					Match: fromClientModelMatch(allElem.Match),
+				}
+			}
+			if allElem.MatchNot != nil && allElem.MatchNot.Not != nil && allElem.MatchNot.Not.Match != nil {
+				res.All[i] = allNestedFilterModel{
+					Not: &notNestedFilterModel{
+						Match: fromClientModelMatch(allElem.MatchNot.Not.Match),
+					},
+				}
+			}
+		}
+	}
+	if filter.MatchAny != nil && len(filter.MatchAny.Any) > 0 {
+		res.Any = make([]anyNestedFilterModel, len(filter.MatchAny.Any))
+		for i, anyElem := range filter.MatchAny.Any {
+			if anyElem.Match != nil {
+				res.Any[i] = anyNestedFilterModel{
+					Match: fromClientModelMatch(anyElem.Match),
+				}
+			}
+			if anyElem.MatchNot != nil && anyElem.MatchNot.Not != nil && anyElem.MatchNot.Not.Match != nil {
+				res.Any[i] = anyNestedFilterModel{
+					Not: &notNestedFilterModel{
+						Match: fromClientModelMatch(anyElem.MatchNot.Not.Match),
+					},
+				}
+			}
+			if anyElem.MatchAll != nil && anyElem.MatchAll.All != nil && len(anyElem.MatchAll.All) > 0 {
+				res.Any[i] = anyNestedFilterModel{
+					All: make([]allNestedFilterModel, len(anyElem.MatchAll.All)),
+				}
+				for j, allElem := range anyElem.MatchAll.All {
+					if allElem.Match != nil {
+						res.Any[i].All[j] = allNestedFilterModel{
+							Match: fromClientModelMatch(allElem.Match),
+						}
+					}
+					if allElem.MatchNot != nil && allElem.MatchNot.Not != nil && allElem.MatchNot.Not.Match != nil {
+						res.Any[i].All[j] = allNestedFilterModel{
+							Not: &notNestedFilterModel{
+								Match: fromClientModelMatch(allElem.MatchNot.Not.Match),
+							},
+						}
+					}
+				}
+			}
+		}
+	}
+	if filter.MatchNot != nil && filter.MatchNot.Not != nil && filter.MatchNot.Not.Match != nil {
+		res.Not = &notNestedFilterModel{
+			Match: fromClientModelMatch(filter.MatchNot.Not.Match),
+		}
+	}
+	return res
+}
+
+// ToClientModel converts the Model to a log filter to use in oodle APIs.
+func (f *Model) ToClientModel() *clientmodels.LogFilter {
+	res := &clientmodels.LogFilter{}
+	if f.Match != nil {
+		res.Match = toClientModelMatch(f.Match)
+	}
+	if len(f.All) > 0 {
+		res.MatchAll = &clientmodels.MatchAll{
+			All: make([]*clientmodels.LogFilter, len(f.All)),
+		}
+		for i, allElem := range f.All {
+			if allElem.Match != nil {
+				res.MatchAll.All[i] = &clientmodels.LogFilter{
+					Match: toClientModelMatch(allElem.Match),
+				}
+			}
+			if allElem.Not != nil && allElem.Not.Match != nil {
+				res.MatchAll.All[i] = &clientmodels.LogFilter{
+					MatchNot: &clientmodels.MatchNot{
+						Not: &clientmodels.LogFilter{
+							Match: toClientModelMatch(allElem.Not.Match),
+						},
+					},
+				}
+			}
+		}
+	}
+	if len(f.Any) > 0 {
+		res.MatchAny = &clientmodels.MatchAny{
+			Any: make([]*clientmodels.LogFilter, len(f.Any)),
+		}
+		for i, filter := range f.Any {
+			if filter.Match != nil {
+				res.MatchAny.Any[i] = &clientmodels.LogFilter{
+					Match: toClientModelMatch(filter.Match),
+				}
+			}
+			if filter.Not != nil && filter.Not.Match != nil {
+				res.MatchAny.Any[i] = &clientmodels.LogFilter{
+					MatchNot: &clientmodels.MatchNot{
+						Not: &clientmodels.LogFilter{
+							Match: toClientModelMatch(filter.Not.Match),
+						},
+					},
+				}
+			}
+			if len(filter.All) > 0 {
+				res.MatchAny.Any[i] = &clientmodels.LogFilter{
+					MatchAll: &clientmodels.MatchAll{
+						All: make([]*clientmodels.LogFilter, len(filter.All)),
+					},
+				}
+				for j, allElem := range filter.All {
+					if allElem.Match != nil {
+						res.MatchAny.Any[i].MatchAll.All[j] = &clientmodels.LogFilter{
+							Match: toClientModelMatch(allElem.Match),
+						}
+					}
+					if allElem.Not != nil && allElem.Not.Match != nil {
+						res.MatchAny.Any[i].MatchAll.All[j] = &clientmodels.LogFilter{
+							MatchNot: &clientmodels.MatchNot{
+								Not: &clientmodels.LogFilter{
+									Match: toClientModelMatch(allElem.Not.Match),
+								},
+							},
+						}
+					}
+				}
+			}
+		}
+	}
+	if f.Not != nil && f.Not.Match != nil {
+		res.MatchNot = &clientmodels.MatchNot{
+			Not: &clientmodels.LogFilter{
+				Match: toClientModelMatch(f.Not.Match),
+			},
+		}
+	}
+	return res
+}
+
+func toClientModelMatch(match *matchModel) *clientmodels.Match {
+	res := &clientmodels.Match{
+		Field:    match.Field.ValueString(),
+		Operator: clientmodels.MatchOperator(match.Operator.ValueString()),
+	}
+
+	if !match.JSONPath.IsNull() {
+		jsonPath := match.JSONPath.ValueString()
+		res.JSONPath = &jsonPath
+	}
+
+	if !match.Value.IsNull() {
+		res.Value = match.Value.ValueString()
+	}
+
+	return res
+}
+
+func fromClientModelMatch(match *clientmodels.Match) *matchModel {
+	res := &matchModel{
+		Field:    types.StringValue(match.Field),
+		Operator: types.StringValue(string(match.Operator)),
+	}
+	if match.JSONPath != nil {
+		res.JSONPath = types.StringValue(*match.JSONPath)
+	}
+	if match.Value != "" {
+		res.Value = types.StringValue(match.Value)
+	}
+	return res
+}
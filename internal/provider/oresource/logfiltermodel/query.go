@@ -0,0 +1,40 @@
+package logfiltermodel
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"terraform-provider-oodle/internal/logfilter"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// FromClientModelAs converts a log filter received from oodle APIs to the
+// form it was configured in: a filter query when configuredQuery is set, and
+// a structured filter otherwise. A filter that cannot be formatted as a query
+// keeps configuredQuery, with a warning, so that the state still matches the
+// configuration.
+func FromClientModelAs(
+	filter *clientmodels.LogFilter,
+	configuredQuery validatorutils.LogFilterQueryValue,
+	diags *diag.Diagnostics,
+) (*Model, validatorutils.LogFilterQueryValue) {
+	if filter == nil {
+		return nil, validatorutils.NewLogFilterQueryNull()
+	}
+	if configuredQuery.IsNull() || configuredQuery.IsUnknown() {
+		return FromClientModel(filter), validatorutils.NewLogFilterQueryNull()
+	}
+
+	query, err := logfilter.Format(filter)
+	if err != nil {
+		diags.AddWarning(
+			"Filter query kept as configured",
+			fmt.Sprintf("The filter returned by Oodle cannot be expressed as a filter query, so "+
+				"filter_query keeps its configured value: %v", err),
+		)
+		return nil, configuredQuery
+	}
+	return nil, validatorutils.NewLogFilterQueryValue(query)
+}
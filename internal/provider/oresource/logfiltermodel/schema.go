@@ -0,0 +1,127 @@
+package logfiltermodel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+var validOperators = map[string]struct{}{
+	"is":            {},
+	"contains":      {},
+	"matches regex": {},
+	"exists":        {},
+}
+
+// Schema returns the attributes of a log filter, for use in a
+// SingleNestedAttribute validated with validatorutils.NewFilterValidator.
+func Schema() map[string]schema.Attribute {
+	matchSchema := map[string]schema.Attribute{
+		"field": schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the log field to match against.",
+		},
+		"json_path": schema.StringAttribute{
+			Optional:    true,
+			Description: "JSONPath to match against a value at a specific path in the JSON field.",
+		},
+		"operator": schema.StringAttribute{
+			Required:    true,
+			Description: "Operator to use for matching. Possible values are: 'is', 'contains', 'matches regex', 'exists'.",
+			Validators: []validator.String{
+				validatorutils.NewChoiceValidator(validOperators),
+			},
+		},
+		"value": schema.StringAttribute{
+			Optional:    true,
+			Description: "Value to match against.",
+		},
+	}
+
+	// Allow only match and not within all filters
+	allNestedFilterSchema := map[string]schema.Attribute{
+		"match": schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  matchSchema,
+			Description: "Simple field matching filter.",
+		},
+		"not": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"match": schema.SingleNestedAttribute{
+					Optional:    true,
+					Attributes:  matchSchema,
+					Description: "Simple field matching filter.",
+				},
+			},
+			Description: "Filter that must not match.",
+		},
+	}
+
+	// Allow match, not and all within any filters
+	anyNestedFilterSchema := map[string]schema.Attribute{
+		"match": schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  matchSchema,
+			Description: "Simple field matching filter.",
+		},
+		"not": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"match": schema.SingleNestedAttribute{
+					Optional:    true,
+					Attributes:  matchSchema,
+					Description: "Simple field matching filter.",
+				},
+			},
+			Description: "Filter that must not match.",
+		},
+		"all": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: allNestedFilterSchema,
+			},
+			Description: "List of filters where all must match.",
+		},
+	}
+
+	// Allow only match within not filter
+	notNestedFilterSchema := map[string]schema.Attribute{
+		"match": schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  matchSchema,
+			Description: "Simple field matching filter.",
+		},
+	}
+
+	// Define the top-level filter schema
+	filterSchema := map[string]schema.Attribute{
+		"match": schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  matchSchema,
+			Description: "Simple field matching filter.",
+		},
+		"all": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: allNestedFilterSchema,
+			},
+			Description: "List of filters where all must match.",
+		},
+		"any": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: anyNestedFilterSchema,
+			},
+			Description: "List of filters where at least one must match.",
+		},
+		"not": schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  notNestedFilterSchema,
+			Description: "Filter that must not match.",
+		},
+	}
+
+	return filterSchema
+}
@@ -0,0 +1,82 @@
+package logmetrics
+
+import (
+	"context"
+	"fmt"
+	stdpath "path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ModifyPlan warns about labels that take their raw value from a high
+// cardinality field, as configured by the provider's high_cardinality_fields.
+func (r *logMetricsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.BaseResource.ModifyPlan(ctx, req, resp)
+
+	// Nothing to check when the resource is destroyed.
+	if req.Plan.Raw.IsNull() || len(r.highCardinalityFields) == 0 {
+		return
+	}
+
+	var labels types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+	if resp.Diagnostics.HasError() || labels.IsNull() || labels.IsUnknown() {
+		return
+	}
+
+	// Labels with unknown nested values are skipped.
+	var models []labelModel
+	if diags := labels.ElementsAs(ctx, &models, false); diags.HasError() {
+		return
+	}
+
+	for i, label := range models {
+		field, pattern, ok := highCardinalityField(r.highCardinalityFields, label.ValueExtractor)
+		if !ok {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("labels").AtListIndex(i).AtName("value_extractor"),
+			"High cardinality label",
+			fmt.Sprintf("Label %q takes its value from %q, which matches the high cardinality field pattern %q. "+
+				"Each distinct value creates new series for every metric of the rule. Consider removing the label, "+
+				"extracting a bounded part of the value with a regex, or setting max_series.",
+				label.Name.ValueString(), field, pattern),
+		)
+	}
+}
+
+// highCardinalityField returns the field or JSON key a label extractor takes
+// its raw value from and the pattern it matches, if it matches one of
+// patterns. Extractors with a regex are not checked, as the regex usually
+// narrows the value down.
+func highCardinalityField(patterns []string, extractor *valueExtractorModel) (string, string, bool) {
+	if extractor == nil || extractor.Field.IsNull() || extractor.Field.IsUnknown() ||
+		!extractor.Regex.IsNull() || extractor.JSONPath.IsUnknown() {
+		return "", "", false
+	}
+
+	field := extractor.Field.ValueString()
+	if !extractor.JSONPath.IsNull() {
+		field = lastJSONPathKey(extractor.JSONPath.ValueString())
+	}
+	for _, pattern := range patterns {
+		if ok, _ := stdpath.Match(pattern, field); ok {
+			return field, pattern, true
+		}
+	}
+	return "", "", false
+}
+
+// lastJSONPathKey returns the last object key of a JSONPath, e.g. "id" for
+// "$.user.id" or "$['request_id']".
+func lastJSONPathKey(jsonPath string) string {
+	key := jsonPath
+	if i := strings.LastIndexAny(key, ".["); i >= 0 {
+		key = key[i+1:]
+	}
+	return strings.Trim(key, `'"]`)
+}
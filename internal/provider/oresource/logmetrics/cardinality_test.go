@@ -0,0 +1,75 @@
+package logmetrics
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestHighCardinalityField(t *testing.T) {
+	patterns := []string{"*_id", "trace_id", "msg"}
+
+	tests := []struct {
+		name      string
+		extractor *valueExtractorModel
+		field     string
+		pattern   string
+	}{
+		{
+			name:      "field",
+			extractor: &valueExtractorModel{Field: types.StringValue("request_id")},
+			field:     "request_id",
+			pattern:   "*_id",
+		},
+		{
+			name:      "exact field",
+			extractor: &valueExtractorModel{Field: types.StringValue("msg")},
+			field:     "msg",
+			pattern:   "msg",
+		},
+		{
+			name: "json path",
+			extractor: &valueExtractorModel{
+				Field:    types.StringValue("body"),
+				JSONPath: types.StringValue("$.user['session_id']"),
+			},
+			field:   "session_id",
+			pattern: "*_id",
+		},
+		{
+			name: "json path narrows field",
+			extractor: &valueExtractorModel{
+				Field:    types.StringValue("msg"),
+				JSONPath: types.StringValue("$.level"),
+			},
+		},
+		{
+			name: "regex",
+			extractor: &valueExtractorModel{
+				Field: types.StringValue("msg"),
+				Regex: types.StringValue(`level=(\w+)`),
+			},
+		},
+		{
+			name:      "low cardinality field",
+			extractor: &valueExtractorModel{Field: types.StringValue("level")},
+		},
+		{
+			name:      "unknown field",
+			extractor: &valueExtractorModel{Field: types.StringUnknown()},
+		},
+		{
+			name: "no extractor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, pattern, ok := highCardinalityField(patterns, tt.extractor)
+			assert.Equal(t, ok, tt.field != "")
+			assert.Equal(t, field, tt.field)
+			assert.Equal(t, pattern, tt.pattern)
+		})
+	}
+}
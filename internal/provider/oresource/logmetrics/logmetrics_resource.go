@@ -3,42 +3,52 @@ package logmetrics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
 	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/providerdata"
 	"terraform-provider-oodle/internal/validatorutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &logMetricsResource{}
-	_ resource.ResourceWithConfigure   = &logMetricsResource{}
-	_ resource.ResourceWithImportState = &logMetricsResource{}
+	_ resource.Resource                     = &logMetricsResource{}
+	_ resource.ResourceWithConfigure        = &logMetricsResource{}
+	_ resource.ResourceWithImportState      = &logMetricsResource{}
+	_ resource.ResourceWithConfigValidators = &logMetricsResource{}
+	_ resource.ResourceWithModifyPlan       = &logMetricsResource{}
 )
 
 const logMetricsResourceName = "logmetrics"
 
-var validOperators = map[string]struct{}{
-	"is":            {},
-	"contains":      {},
-	"matches regex": {},
-	"exists":        {},
+var validMetricTypes = map[string]struct{}{
+	"log_count":      {},
+	"counter":        {},
+	"gauge":          {},
+	"histogram":      {},
+	"distinct_count": {},
+	"summary":        {},
 }
 
-var validMetricTypes = map[string]struct{}{
-	"log_count": {},
-	"counter":   {},
-	"gauge":     {},
-	"histogram": {},
+var validTemporalities = map[string]struct{}{
+	"cumulative": {},
+	"delta":      {},
 }
 
 // logMetricsResource is the resource implementation.
 type logMetricsResource struct {
 	oresource.BaseResource[*clientmodels.LogMetrics, *logMetricsResourceModel]
+
+	// highCardinalityFields are the provider's high cardinality field
+	// patterns, used to warn about labels in ModifyPlan.
+	highCardinalityFields []string
 }
 
 func NewLogMetricsResource() resource.Resource {
@@ -62,115 +72,12 @@ func NewLogMetricsResource() resource.Resource {
 	}
 }
 
-// getFilterSchema returns the schema for a filter.
-func getFilterSchema() map[string]schema.Attribute {
-	matchSchema := map[string]schema.Attribute{
-		"field": schema.StringAttribute{
-			Required:    true,
-			Description: "Name of the log field to match against.",
-		},
-		"json_path": schema.StringAttribute{
-			Optional:    true,
-			Description: "JSONPath to match against a value at a specific path in the JSON field.",
-		},
-		"operator": schema.StringAttribute{
-			Required:    true,
-			Description: "Operator to use for matching. Possible values are: 'is', 'contains', 'matches regex', 'exists'.",
-			Validators: []validator.String{
-				validatorutils.NewChoiceValidator(validOperators),
-			},
-		},
-		"value": schema.StringAttribute{
-			Optional:    true,
-			Description: "Value to match against.",
-		},
-	}
-
-	// Allow only match and not within all filters
-	allNestedFilterSchema := map[string]schema.Attribute{
-		"match": schema.SingleNestedAttribute{
-			Optional:    true,
-			Attributes:  matchSchema,
-			Description: "Simple field matching filter.",
-		},
-		"not": schema.SingleNestedAttribute{
-			Optional: true,
-			Attributes: map[string]schema.Attribute{
-				"match": schema.SingleNestedAttribute{
-					Optional:    true,
-					Attributes:  matchSchema,
-					Description: "Simple field matching filter.",
-				},
-			},
-			Description: "Filter that must not match.",
-		},
-	}
-
-	// Allow match, not and all within any filters
-	anyNestedFilterSchema := map[string]schema.Attribute{
-		"match": schema.SingleNestedAttribute{
-			Optional:    true,
-			Attributes:  matchSchema,
-			Description: "Simple field matching filter.",
-		},
-		"not": schema.SingleNestedAttribute{
-			Optional: true,
-			Attributes: map[string]schema.Attribute{
-				"match": schema.SingleNestedAttribute{
-					Optional:    true,
-					Attributes:  matchSchema,
-					Description: "Simple field matching filter.",
-				},
-			},
-			Description: "Filter that must not match.",
-		},
-		"all": schema.ListNestedAttribute{
-			Optional: true,
-			NestedObject: schema.NestedAttributeObject{
-				Attributes: allNestedFilterSchema,
-			},
-			Description: "List of filters where all must match.",
-		},
-	}
-
-	// Allow only match within not filter
-	notNestedFilterSchema := map[string]schema.Attribute{
-		"match": schema.SingleNestedAttribute{
-			Optional:    true,
-			Attributes:  matchSchema,
-			Description: "Simple field matching filter.",
-		},
-	}
-
-	// Define the top-level filter schema
-	filterSchema := map[string]schema.Attribute{
-		"match": schema.SingleNestedAttribute{
-			Optional:    true,
-			Attributes:  matchSchema,
-			Description: "Simple field matching filter.",
-		},
-		"all": schema.ListNestedAttribute{
-			Optional: true,
-			NestedObject: schema.NestedAttributeObject{
-				Attributes: allNestedFilterSchema,
-			},
-			Description: "List of filters where all must match.",
-		},
-		"any": schema.ListNestedAttribute{
-			Optional: true,
-			NestedObject: schema.NestedAttributeObject{
-				Attributes: anyNestedFilterSchema,
-			},
-			Description: "List of filters where at least one must match.",
-		},
-		"not": schema.SingleNestedAttribute{
-			Optional:    true,
-			Attributes:  notNestedFilterSchema,
-			Description: "Filter that must not match.",
-		},
+// Configure adds the provider configured client to the resource.
+func (r *logMetricsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.BaseResource.Configure(ctx, req, resp)
+	if data, ok := req.ProviderData.(*providerdata.Data); ok {
+		r.highCardinalityFields = data.HighCardinalityFields
 	}
-
-	return filterSchema
 }
 
 // Metadata returns the resource type name.
@@ -178,10 +85,22 @@ func (r *logMetricsResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_logmetrics"
 }
 
+// ConfigValidators returns the resource-level validators.
+func (r *logMetricsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewConflictingAttributesValidator(
+			path.Root("filter"),
+			path.Root("filter_query"),
+		),
+		validatorutils.NewLogMetricsDefinitionsValidator(),
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *logMetricsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the log metrics rule.",
@@ -217,8 +136,12 @@ func (r *logMetricsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 									Description: "JSONPath to extract a nested value from a JSON field.",
 								},
 								"regex": schema.StringAttribute{
-									Optional:    true,
-									Description: "Regex pattern to extract a value from the field.",
+									Optional: true,
+									Description: "Regex pattern to extract a value from the field. Must be a valid Rust regex " +
+										"with exactly one capture group, which holds the extracted value.",
+									Validators: []validator.String{
+										validatorutils.NewRustRegexExtractorValidator(),
+									},
 								},
 							},
 							Description: "Configuration for extracting label values from log fields.",
@@ -229,12 +152,32 @@ func (r *logMetricsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"filter": schema.SingleNestedAttribute{
 				Optional:    true,
-				Attributes:  getFilterSchema(),
-				Description: "Filter to determine which logs to process.",
+				Attributes:  logfiltermodel.Schema(),
+				Description: "Filter to determine which logs to process. Cannot be used together with filter_query.",
 				Validators: []validator.Object{
 					validatorutils.NewFilterValidator(),
 				},
 			},
+			"filter_query": schema.StringAttribute{
+				Optional:   true,
+				CustomType: validatorutils.NewLogFilterQueryType(),
+				Description: "Filter to determine which logs to process, written as a query such as " +
+					"`service:checkout AND level:(error OR fatal) AND NOT msg:\"healthcheck\"`. " +
+					"Supports `field:value`, `field:~value` (contains), `field:/regex/`, `field:*` (exists) and " +
+					"`field[json_path]:value` terms combined with AND, OR, NOT and parentheses; " +
+					"adjacent terms are joined with AND. Cannot be used together with filter.",
+				Validators: []validator.String{
+					validatorutils.NewLogFilterQueryValidator(),
+				},
+			},
+			"max_series": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of series each metric of the rule may generate. " +
+					"Logs that would create series beyond the limit are dropped. Must be at least 1.",
+				Validators: []validator.Int64{
+					validatorutils.NewInt64AtLeastValidator(1),
+				},
+			},
 			"metric_definitions": schema.ListNestedAttribute{
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -252,7 +195,9 @@ func (r *logMetricsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 								"  - `log_count` - Counts the number of logs that match the filter.\n" +
 								"  - `counter` - Extracts and sums numeric values from fields.\n" +
 								"  - `gauge` - Records the latest numeric value from fields.\n" +
-								"  - `histogram` - Creates distribution buckets of numeric values from fields.",
+								"  - `histogram` - Creates distribution buckets of numeric values from fields.\n" +
+								"  - `distinct_count` - Counts the distinct values of fields.\n" +
+								"  - `summary` - Reports quantiles of numeric values from fields.",
 							Validators: []validator.String{
 								validatorutils.NewChoiceValidator(validMetricTypes),
 							},
@@ -266,8 +211,100 @@ func (r *logMetricsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							Description: "JSONPath to extract a numeric value from a JSON field. Cannot be used together with regex.",
 						},
 						"regex": schema.StringAttribute{
+							Optional: true,
+							Description: "Regex pattern to extract a numeric value from the field. Must be a valid Rust regex " +
+								"with exactly one capture group, which holds the extracted value. Cannot be used together with json_path.",
+							Validators: []validator.String{
+								validatorutils.NewRustRegexExtractorValidator(),
+							},
+						},
+						"unit": schema.StringAttribute{
 							Optional:    true,
-							Description: "Regex pattern to extract a numeric value from the field. Cannot be used together with json_path.",
+							Description: "Unit of the metric values, e.g. `ms`, `s` or `By`.",
+						},
+						"temporality": schema.StringAttribute{
+							Optional: true,
+							Description: "Aggregation temporality of the metric. Possible values are `cumulative` and `delta`. " +
+								"Defaults to `cumulative`. Cannot be set on gauge metrics.",
+							Validators: []validator.String{
+								validatorutils.NewChoiceValidator(validTemporalities),
+							},
+						},
+						"buckets": schema.SingleNestedAttribute{
+							Optional: true,
+							Description: "Bucket boundaries of a histogram metric. Exactly one of explicit, exponential or linear must be set. " +
+								"Only used when type is 'histogram'.",
+							Attributes: map[string]schema.Attribute{
+								"explicit": schema.ListAttribute{
+									Optional:    true,
+									ElementType: types.Float64Type,
+									Description: "Upper bounds of the buckets, in increasing order.",
+									Validators: []validator.List{
+										validatorutils.NewListLengthAtLeastValidator(1),
+										validatorutils.NewIncreasingFloat64ListValidator(),
+									},
+								},
+								"exponential": schema.SingleNestedAttribute{
+									Optional:    true,
+									Description: "Generates bucket bounds that grow by a constant factor.",
+									Attributes: map[string]schema.Attribute{
+										"start": schema.Float64Attribute{
+											Required:    true,
+											Description: "Upper bound of the first bucket. Must be greater than 0.",
+											Validators: []validator.Float64{
+												validatorutils.NewFloat64GreaterThanValidator(0),
+											},
+										},
+										"factor": schema.Float64Attribute{
+											Required:    true,
+											Description: "Factor between the bounds of consecutive buckets. Must be greater than 1.",
+											Validators: []validator.Float64{
+												validatorutils.NewFloat64GreaterThanValidator(1),
+											},
+										},
+										"count": schema.Int64Attribute{
+											Required:    true,
+											Description: "Number of buckets.",
+											Validators: []validator.Int64{
+												validatorutils.NewInt64AtLeastValidator(1),
+											},
+										},
+									},
+								},
+								"linear": schema.SingleNestedAttribute{
+									Optional:    true,
+									Description: "Generates bucket bounds that grow by a constant width.",
+									Attributes: map[string]schema.Attribute{
+										"start": schema.Float64Attribute{
+											Required:    true,
+											Description: "Upper bound of the first bucket.",
+										},
+										"width": schema.Float64Attribute{
+											Required:    true,
+											Description: "Width of each bucket. Must be greater than 0.",
+											Validators: []validator.Float64{
+												validatorutils.NewFloat64GreaterThanValidator(0),
+											},
+										},
+										"count": schema.Int64Attribute{
+											Required:    true,
+											Description: "Number of buckets.",
+											Validators: []validator.Int64{
+												validatorutils.NewInt64AtLeastValidator(1),
+											},
+										},
+									},
+								},
+							},
+						},
+						"quantiles": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.Float64Type,
+							Description: "Quantiles to report, each between 0 and 1, e.g. `[0.5, 0.9, 0.99]`. " +
+								"Required when type is 'summary' and not used otherwise.",
+							Validators: []validator.List{
+								validatorutils.NewQuantilesValidator(),
+							},
 						},
 					},
 				},
@@ -7,18 +7,28 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"terraform-provider-oodle/internal/logfilter"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
 	"terraform-provider-oodle/internal/resourceutils"
+	"terraform-provider-oodle/internal/validatorutils"
 )
 
 type logMetricsResourceModel struct {
-	ID                types.String            `tfsdk:"id"`
-	Name              types.String            `tfsdk:"name"`
-	Labels            []labelModel            `tfsdk:"labels"`
-	Filter            *filterModel            `tfsdk:"filter"`
-	MetricDefinitions []metricDefinitionModel `tfsdk:"metric_definitions"`
+	resourceutils.InstanceModel
+
+	ID                types.String                       `tfsdk:"id"`
+	Name              types.String                       `tfsdk:"name"`
+	Labels            []labelModel                       `tfsdk:"labels"`
+	Filter            *logfiltermodel.Model              `tfsdk:"filter"`
+	FilterQuery       validatorutils.LogFilterQueryValue `tfsdk:"filter_query"`
+	MetricDefinitions []metricDefinitionModel            `tfsdk:"metric_definitions"`
+	MaxSeries         types.Int64                        `tfsdk:"max_series"`
+
+	// configuredFilterQuery is the filter_query the resource was planned or
+	// read with, as passed to PreserveConfigured.
+	configuredFilterQuery validatorutils.LogFilterQueryValue
 }
 
 type labelModel struct {
@@ -33,44 +43,38 @@ type valueExtractorModel struct {
 	Regex    types.String `tfsdk:"regex"`
 }
 
-type matchModel struct {
-	Field    types.String `tfsdk:"field"`
-	JSONPath types.String `tfsdk:"json_path"`
-	Operator types.String `tfsdk:"operator"`
-	Value    types.String `tfsdk:"value"`
-}
-
-type allNestedFilterModel struct {
-	Match *matchModel           `tfsdk:"match"`
-	Not   *notNestedFilterModel `tfsdk:"not"`
-}
-
-type anyNestedFilterModel struct {
-	Match *matchModel            `tfsdk:"match"`
-	Not   *notNestedFilterModel  `tfsdk:"not"`
-	All   []allNestedFilterModel `tfsdk:"all"`
+type metricDefinitionModel struct {
+	Name        types.String    `tfsdk:"name"`
+	Type        types.String    `tfsdk:"type"`
+	Field       types.String    `tfsdk:"field"`
+	JSONPath    types.String    `tfsdk:"json_path"`
+	Regex       types.String    `tfsdk:"regex"`
+	Unit        types.String    `tfsdk:"unit"`
+	Temporality types.String    `tfsdk:"temporality"`
+	Buckets     *bucketsModel   `tfsdk:"buckets"`
+	Quantiles   []types.Float64 `tfsdk:"quantiles"`
 }
 
-type notNestedFilterModel struct {
-	Match *matchModel `tfsdk:"match"`
+type bucketsModel struct {
+	Explicit    []types.Float64          `tfsdk:"explicit"`
+	Exponential *exponentialBucketsModel `tfsdk:"exponential"`
+	Linear      *linearBucketsModel      `tfsdk:"linear"`
 }
 
-type filterModel struct {
-	Match *matchModel            `tfsdk:"match"`
-	All   []allNestedFilterModel `tfsdk:"all"`
-	Any   []anyNestedFilterModel `tfsdk:"any"`
-	Not   *notNestedFilterModel  `tfsdk:"not"`
+type exponentialBucketsModel struct {
+	Start  types.Float64 `tfsdk:"start"`
+	Factor types.Float64 `tfsdk:"factor"`
+	Count  types.Int64   `tfsdk:"count"`
 }
 
-type metricDefinitionModel struct {
-	Name     types.String `tfsdk:"name"`
-	Type     types.String `tfsdk:"type"`
-	Field    types.String `tfsdk:"field"`
-	JSONPath types.String `tfsdk:"json_path"`
-	Regex    types.String `tfsdk:"regex"`
+type linearBucketsModel struct {
+	Start types.Float64 `tfsdk:"start"`
+	Width types.Float64 `tfsdk:"width"`
+	Count types.Int64   `tfsdk:"count"`
 }
 
 var _ resourceutils.ResourceModel[*clientmodels.LogMetrics] = (*logMetricsResourceModel)(nil)
+var _ resourceutils.ConfiguredFormPreserver[*logMetricsResourceModel] = (*logMetricsResourceModel)(nil)
 
 func (m *logMetricsResourceModel) GetID() types.String {
 	return m.ID
@@ -80,11 +84,19 @@ func (m *logMetricsResourceModel) SetID(id types.String) {
 	m.ID = id
 }
 
+// PreserveConfigured keeps the filter in the form prior was configured in.
+func (m *logMetricsResourceModel) PreserveConfigured(prior *logMetricsResourceModel) {
+	m.configuredFilterQuery = prior.FilterQuery
+}
+
 func (m *logMetricsResourceModel) FromClientModel(
 	ctx context.Context,
 	model *clientmodels.LogMetrics,
 	diagnosticsOut *diag.Diagnostics,
 ) {
+	// Keep the filter in the form it was configured in.
+	configuredQuery := m.configuredFilterQuery
+
 	// Reset the model to clear any existing data
 	*m = logMetricsResourceModel{}
 
@@ -117,69 +129,10 @@ func (m *logMetricsResourceModel) FromClientModel(
 	}
 
 	// Convert filter
-	if model.Filter != nil {
-		m.Filter = &filterModel{}
-		if model.Filter.Match != nil {
-			m.Filter.Match = m.fromClientModelMatchModel(model.Filter.Match)
-		}
-		if model.Filter.MatchAll != nil && len(model.Filter.MatchAll.All) > 0 {
-			m.Filter.All = make([]allNestedFilterModel, len(model.Filter.MatchAll.All))
-			for i, allElem := range model.Filter.MatchAll.All {
-				if allElem.Match != nil {
-					m.Filter.All[i] = allNestedFilterModel{
-						Match: m.fromClientModelMatchModel(allElem.Match),
-					}
-				}
-				if allElem.MatchNot != nil && allElem.MatchNot.Not != nil && allElem.MatchNot.Not.Match != nil {
-					m.Filter.All[i] = allNestedFilterModel{
-						Not: &notNestedFilterModel{
-							Match: m.fromClientModelMatchModel(allElem.MatchNot.Not.Match),
-						},
-					}
-				}
-			}
-		}
-		if model.Filter.MatchAny != nil && len(model.Filter.MatchAny.Any) > 0 {
-			m.Filter.Any = make([]anyNestedFilterModel, len(model.Filter.MatchAny.Any))
-			for i, anyElem := range model.Filter.MatchAny.Any {
-				if anyElem.Match != nil {
-					m.Filter.Any[i] = anyNestedFilterModel{
-						Match: m.fromClientModelMatchModel(anyElem.Match),
-					}
-				}
-				if anyElem.MatchNot != nil && anyElem.MatchNot.Not != nil && anyElem.MatchNot.Not.Match != nil {
-					m.Filter.Any[i] = anyNestedFilterModel{
-						Not: &notNestedFilterModel{
-							Match: m.fromClientModelMatchModel(anyElem.MatchNot.Not.Match),
-						},
-					}
-				}
-				if anyElem.MatchAll != nil && anyElem.MatchAll.All != nil && len(anyElem.MatchAll.All) > 0 {
-					m.Filter.Any[i] = anyNestedFilterModel{
-						All: make([]allNestedFilterModel, len(anyElem.MatchAll.All)),
-					}
-					for j, allElem := range anyElem.MatchAll.All {
-						if allElem.Match != nil {
-							m.Filter.Any[i].All[j] = allNestedFilterModel{
-								Match: m.fromClientModelMatchModel(allElem.Match),
-							}
-						}
-						if allElem.MatchNot != nil && allElem.MatchNot.Not != nil && allElem.MatchNot.Not.Match != nil {
-							m.Filter.Any[i].All[j] = allNestedFilterModel{
-								Not: &notNestedFilterModel{
-									Match: m.fromClientModelMatchModel(allElem.MatchNot.Not.Match),
-								},
-							}
-						}
-					}
-				}
-			}
-		}
-		if model.Filter.MatchNot != nil && model.Filter.MatchNot.Not != nil && model.Filter.MatchNot.Not.Match != nil {
-			m.Filter.Not = &notNestedFilterModel{
-				Match: m.fromClientModelMatchModel(model.Filter.MatchNot.Not.Match),
-			}
-		}
+	m.Filter, m.FilterQuery = logfiltermodel.FromClientModelAs(model.Filter, configuredQuery, diagnosticsOut)
+
+	if model.MaxSeries != 0 {
+		m.MaxSeries = types.Int64Value(model.MaxSeries)
 	}
 
 	// Convert metric definitions
@@ -199,6 +152,18 @@ func (m *logMetricsResourceModel) FromClientModel(
 			if def.Regex != nil {
 				m.MetricDefinitions[i].Regex = types.StringValue(*def.Regex)
 			}
+			if def.Unit != "" {
+				m.MetricDefinitions[i].Unit = types.StringValue(def.Unit)
+			}
+			if def.Temporality != "" {
+				m.MetricDefinitions[i].Temporality = types.StringValue(string(def.Temporality))
+			}
+			if def.Buckets != nil {
+				m.MetricDefinitions[i].Buckets = fromClientModelBuckets(def.Buckets)
+			}
+			for _, q := range def.Quantiles {
+				m.MetricDefinitions[i].Quantiles = append(m.MetricDefinitions[i].Quantiles, types.Float64Value(q))
+			}
 		}
 	}
 }
@@ -247,87 +212,19 @@ func (m *logMetricsResourceModel) ToClientModel(
 	}
 
 	// Convert filter
-	if m.Filter != nil {
-		model.Filter = &clientmodels.LogFilter{}
-		if m.Filter.Match != nil {
-			model.Filter.Match = m.toClientModelFilterMatch(m.Filter.Match)
-		}
-		if len(m.Filter.All) > 0 {
-			model.Filter.MatchAll = &clientmodels.MatchAll{
-				All: make([]*clientmodels.LogFilter, len(m.Filter.All)),
-			}
-			for i, allElem := range m.Filter.All {
-				if allElem.Match != nil {
-					model.Filter.MatchAll.All[i] = &clientmodels.LogFilter{
-						Match: m.toClientModelFilterMatch(allElem.Match),
-					}
-				}
-				if allElem.Not != nil && allElem.Not.Match != nil {
-					model.Filter.MatchAll.All[i] = &clientmodels.LogFilter{
-						MatchNot: &clientmodels.MatchNot{
-							Not: &clientmodels.LogFilter{
-								Match: m.toClientModelFilterMatch(allElem.Not.Match),
-							},
-						},
-					}
-				}
-			}
-		}
-		if len(m.Filter.Any) > 0 {
-			model.Filter.MatchAny = &clientmodels.MatchAny{
-				Any: make([]*clientmodels.LogFilter, len(m.Filter.Any)),
-			}
-			for i, filter := range m.Filter.Any {
-				tflog.Debug(ctx, "filter", map[string]any{
-					"filter": filter,
-				})
-				if filter.Match != nil {
-					model.Filter.MatchAny.Any[i] = &clientmodels.LogFilter{
-						Match: m.toClientModelFilterMatch(filter.Match),
-					}
-				}
-				if filter.Not != nil && filter.Not.Match != nil {
-					model.Filter.MatchAny.Any[i] = &clientmodels.LogFilter{
-						MatchNot: &clientmodels.MatchNot{
-							Not: &clientmodels.LogFilter{
-								Match: m.toClientModelFilterMatch(filter.Not.Match),
-							},
-						},
-					}
-				}
-				if len(filter.All) > 0 {
-					model.Filter.MatchAny.Any[i] = &clientmodels.LogFilter{
-						MatchAll: &clientmodels.MatchAll{
-							All: make([]*clientmodels.LogFilter, len(filter.All)),
-						},
-					}
-					for j, allElem := range filter.All {
-						if allElem.Match != nil {
-							model.Filter.MatchAny.Any[i].MatchAll.All[j] = &clientmodels.LogFilter{
-								Match: m.toClientModelFilterMatch(allElem.Match),
-							}
-						}
-						if allElem.Not != nil && allElem.Not.Match != nil {
-							model.Filter.MatchAny.Any[i].MatchAll.All[j] = &clientmodels.LogFilter{
-								MatchNot: &clientmodels.MatchNot{
-									Not: &clientmodels.LogFilter{
-										Match: m.toClientModelFilterMatch(allElem.Not.Match),
-									},
-								},
-							}
-						}
-					}
-				}
-			}
-		}
-		if m.Filter.Not != nil && m.Filter.Not.Match != nil {
-			model.Filter.MatchNot = &clientmodels.MatchNot{
-				Not: &clientmodels.LogFilter{
-					Match: m.toClientModelFilterMatch(m.Filter.Not.Match),
-				},
-			}
+	if !m.FilterQuery.IsNull() && !m.FilterQuery.IsUnknown() {
+		model.Filter, err = logfilter.Parse(m.FilterQuery.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse filter_query: %v", err)
 		}
 	}
+	if m.Filter != nil {
+		model.Filter = m.Filter.ToClientModel()
+	}
+
+	if !m.MaxSeries.IsNull() {
+		model.MaxSeries = m.MaxSeries.ValueInt64()
+	}
 
 	// Convert metric definitions
 	if len(m.MetricDefinitions) > 0 {
@@ -348,40 +245,64 @@ func (m *logMetricsResourceModel) ToClientModel(
 				regex := def.Regex.ValueString()
 				model.MetricDefinitions[i].Regex = &regex
 			}
+			if !def.Unit.IsNull() {
+				model.MetricDefinitions[i].Unit = def.Unit.ValueString()
+			}
+			if !def.Temporality.IsNull() {
+				model.MetricDefinitions[i].Temporality = clientmodels.AggregationTemporality(def.Temporality.ValueString())
+			}
+			if def.Buckets != nil {
+				model.MetricDefinitions[i].Buckets = toClientModelBuckets(def.Buckets)
+			}
+			for _, q := range def.Quantiles {
+				model.MetricDefinitions[i].Quantiles = append(model.MetricDefinitions[i].Quantiles, q.ValueFloat64())
+			}
 		}
 	}
 
 	return nil
 }
 
-func (m *logMetricsResourceModel) toClientModelFilterMatch(match *matchModel) *clientmodels.Match {
-	res := &clientmodels.Match{
-		Field:    match.Field.ValueString(),
-		Operator: clientmodels.MatchOperator(match.Operator.ValueString()),
+func toClientModelBuckets(buckets *bucketsModel) *clientmodels.HistogramBuckets {
+	res := &clientmodels.HistogramBuckets{}
+	for _, bound := range buckets.Explicit {
+		res.Explicit = append(res.Explicit, bound.ValueFloat64())
 	}
-
-	if !match.JSONPath.IsNull() {
-		jsonPath := match.JSONPath.ValueString()
-		res.JSONPath = &jsonPath
+	if buckets.Exponential != nil {
+		res.Exponential = &clientmodels.ExponentialBuckets{
+			Start:  buckets.Exponential.Start.ValueFloat64(),
+			Factor: buckets.Exponential.Factor.ValueFloat64(),
+			Count:  buckets.Exponential.Count.ValueInt64(),
+		}
 	}
-
-	if !match.Value.IsNull() {
-		res.Value = match.Value.ValueString()
+	if buckets.Linear != nil {
+		res.Linear = &clientmodels.LinearBuckets{
+			Start: buckets.Linear.Start.ValueFloat64(),
+			Width: buckets.Linear.Width.ValueFloat64(),
+			Count: buckets.Linear.Count.ValueInt64(),
+		}
 	}
-
 	return res
 }
 
-func (m *logMetricsResourceModel) fromClientModelMatchModel(match *clientmodels.Match) *matchModel {
-	res := &matchModel{
-		Field:    types.StringValue(match.Field),
-		Operator: types.StringValue(string(match.Operator)),
+func fromClientModelBuckets(buckets *clientmodels.HistogramBuckets) *bucketsModel {
+	res := &bucketsModel{}
+	for _, bound := range buckets.Explicit {
+		res.Explicit = append(res.Explicit, types.Float64Value(bound))
 	}
-	if match.JSONPath != nil {
-		res.JSONPath = types.StringValue(*match.JSONPath)
+	if buckets.Exponential != nil {
+		res.Exponential = &exponentialBucketsModel{
+			Start:  types.Float64Value(buckets.Exponential.Start),
+			Factor: types.Float64Value(buckets.Exponential.Factor),
+			Count:  types.Int64Value(buckets.Exponential.Count),
+		}
 	}
-	if match.Value != "" {
-		res.Value = types.StringValue(match.Value)
+	if buckets.Linear != nil {
+		res.Linear = &linearBucketsModel{
+			Start: types.Float64Value(buckets.Linear.Start),
+			Width: types.Float64Value(buckets.Linear.Width),
+			Count: types.Int64Value(buckets.Linear.Count),
+		}
 	}
 	return res
 }
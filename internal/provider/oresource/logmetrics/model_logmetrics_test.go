@@ -6,9 +6,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/rubrikinc/testwell/assert"
 
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/validatorutils"
 )
 
 func TestLogMetricsModel(t *testing.T) {
@@ -110,3 +112,124 @@ func TestLogMetricsModel(t *testing.T) {
 
 	assert.DeepEqual(t, clientModel, newClientModel)
 }
+
+func TestLogMetricsModelFilterQuery(t *testing.T) {
+	ctx := context.Background()
+
+	resourceModel := &logMetricsResourceModel{
+		ID:          types.StringValue(uuid.New().String()),
+		Name:        types.StringValue("test_metrics"),
+		FilterQuery: validatorutils.NewLogFilterQueryValue(`service:checkout level:(error OR fatal) NOT msg:"healthcheck"`),
+		MetricDefinitions: []metricDefinitionModel{
+			{
+				Name: types.StringValue("metric1"),
+				Type: types.StringValue(string(clientmodels.LogCountMetricDefinition)),
+			},
+		},
+	}
+
+	clientModel := &clientmodels.LogMetrics{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, clientModel))
+	assert.Equal(t, len(clientModel.Filter.MatchAll.All), 3)
+
+	// A configured filter_query is read back in canonical form.
+	diags := &diag.Diagnostics{}
+	stateModel := &logMetricsResourceModel{}
+	stateModel.PreserveConfigured(resourceModel)
+	stateModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, stateModel.Filter == nil)
+	assert.Equal(t,
+		stateModel.FilterQuery.ValueString(),
+		`service:checkout AND (level:error OR level:fatal) AND NOT msg:healthcheck`,
+	)
+
+	newClientModel := &clientmodels.LogMetrics{}
+	assert.Nil(t, stateModel.ToClientModel(ctx, newClientModel))
+	assert.DeepEqual(t, clientModel, newClientModel)
+
+	// Without a prior filter_query, the structured filter is used.
+	importedModel := &logMetricsResourceModel{}
+	importedModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, importedModel.FilterQuery.IsNull())
+	assert.Equal(t, len(importedModel.Filter.All), 3)
+}
+
+func TestLogMetricsModelUnformattableFilterQuery(t *testing.T) {
+	ctx := context.Background()
+	configured := &logMetricsResourceModel{
+		FilterQuery: validatorutils.NewLogFilterQueryValue(`service:checkout`),
+	}
+	clientModel := &clientmodels.LogMetrics{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "test_metrics",
+		Filter: &clientmodels.LogFilter{
+			Match: &clientmodels.Match{Field: "service", Operator: "unknown", Value: "checkout"},
+		},
+	}
+
+	// A filter that cannot be formatted keeps the configured filter_query.
+	diags := &diag.Diagnostics{}
+	stateModel := &logMetricsResourceModel{}
+	stateModel.PreserveConfigured(configured)
+	stateModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, diags.WarningsCount(), 1)
+	assert.True(t, stateModel.Filter == nil)
+	assert.Equal(t, stateModel.FilterQuery.ValueString(), `service:checkout`)
+}
+
+func TestLogMetricsModelMetricOptions(t *testing.T) {
+	ctx := context.Background()
+
+	clientModel := &clientmodels.LogMetrics{
+		ID:        clientmodels.ID{UUID: uuid.New()},
+		Name:      "test_metrics",
+		MaxSeries: 10000,
+		MetricDefinitions: []*clientmodels.MetricDefinition{
+			{
+				Name:        "latency_explicit",
+				Type:        clientmodels.HistogramMetricDefinition,
+				Field:       "latency_ms",
+				Unit:        "ms",
+				Temporality: clientmodels.DeltaTemporality,
+				Buckets:     &clientmodels.HistogramBuckets{Explicit: []float64{5, 10, 25, 50, 100}},
+			},
+			{
+				Name:    "latency_exponential",
+				Type:    clientmodels.HistogramMetricDefinition,
+				Field:   "latency_ms",
+				Buckets: &clientmodels.HistogramBuckets{Exponential: &clientmodels.ExponentialBuckets{Start: 1, Factor: 2, Count: 10}},
+			},
+			{
+				Name:    "size_linear",
+				Type:    clientmodels.HistogramMetricDefinition,
+				Field:   "size",
+				Unit:    "By",
+				Buckets: &clientmodels.HistogramBuckets{Linear: &clientmodels.LinearBuckets{Start: 0, Width: 1024, Count: 8}},
+			},
+			{
+				Name:      "latency_summary",
+				Type:      clientmodels.SummaryMetricDefinition,
+				Field:     "latency_ms",
+				Quantiles: []float64{0.5, 0.9, 0.99},
+			},
+			{
+				Name:  "users",
+				Type:  clientmodels.DistinctCountMetricDefinition,
+				Field: "user_id",
+			},
+		},
+	}
+
+	resourceModel := &logMetricsResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.LogMetrics{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
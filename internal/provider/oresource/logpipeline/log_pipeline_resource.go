@@ -0,0 +1,251 @@
+package logpipeline
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &logPipelineResource{}
+	_ resource.ResourceWithConfigure        = &logPipelineResource{}
+	_ resource.ResourceWithImportState      = &logPipelineResource{}
+	_ resource.ResourceWithConfigValidators = &logPipelineResource{}
+)
+
+const logPipelinesResourcePath = "log-pipelines"
+
+// logPipelineResource is the resource implementation.
+type logPipelineResource struct {
+	oresource.BaseResource[*clientmodels.LogPipeline, *logPipelineResourceModel]
+}
+
+func NewLogPipelineResource() resource.Resource {
+	modelCreator := func() *clientmodels.LogPipeline {
+		return &clientmodels.LogPipeline{}
+	}
+	return &logPipelineResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.LogPipeline, *logPipelineResourceModel](
+			func() *logPipelineResourceModel {
+				return &logPipelineResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.LogPipeline] {
+				return oodlehttp.NewModelClient[*clientmodels.LogPipeline](
+					oodleHttpClient,
+					logPipelinesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *logPipelineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_pipeline"
+}
+
+// ConfigValidators returns the validators that need the whole configuration.
+func (r *logPipelineResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewLogPipelineProcessorsValidator(),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *logPipelineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a log pipeline. Pipelines parse, enrich and redact logs before they are stored, " +
+			"so that the fields they produce can be used by log metrics and queries.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the log pipeline.",
+				Validators: []validator.String{
+					validatorutils.NewUUIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the log pipeline.",
+			},
+			"processors": schema.ListNestedAttribute{
+				Required: true,
+				Description: "Processors applied to each log in order. Each processor sees the fields added or " +
+					"changed by the processors before it. Exactly one of grok_parser, regex_parser, json_parser, " +
+					"rename_field, remove_field, add_attribute or redact must be set on each processor.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Human-readable label for the processor.",
+						},
+						"filter": schema.SingleNestedAttribute{
+							Optional:    true,
+							Attributes:  logfiltermodel.Schema(),
+							Description: "Filter to determine which logs the processor applies to. Defaults to all logs.",
+							Validators: []validator.Object{
+								validatorutils.NewFilterValidator(),
+							},
+						},
+						"grok_parser": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Parses a field with grok patterns. The named captures of the first matching pattern become fields of the log.",
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Required:    true,
+									Description: "Name of the field to parse.",
+								},
+								"patterns": schema.ListAttribute{
+									Required:    true,
+									ElementType: types.StringType,
+									Description: "Grok patterns tried in order until one matches, e.g. " +
+										"`%{IP:client} %{WORD:method} %{URIPATHPARAM:path}`.",
+									Validators: []validator.List{
+										validatorutils.NewListLengthAtLeastValidator(1),
+									},
+								},
+								"pattern_definitions": schema.MapAttribute{
+									Optional:    true,
+									ElementType: types.StringType,
+									Description: "Custom patterns that can be referenced from patterns, keyed by name.",
+								},
+							},
+						},
+						"regex_parser": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Parses a field with a regex. Its named capture groups become fields of the log.",
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Required:    true,
+									Description: "Name of the field to parse.",
+								},
+								"regex": schema.StringAttribute{
+									Required: true,
+									Description: "Regex to match against the field. Must be a valid Rust regex with at least one " +
+										"named capture group, e.g. `(?P<status>\\d{3}) (?P<bytes>\\d+)`.",
+									Validators: []validator.String{
+										validatorutils.NewRustRegexParserValidator(),
+									},
+								},
+							},
+						},
+						"json_parser": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Parses a field holding a JSON object into fields of the log.",
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Required:    true,
+									Description: "Name of the field to parse.",
+								},
+								"target_field": schema.StringAttribute{
+									Optional: true,
+									Description: "Field to store the parsed object under. " +
+										"Defaults to adding the keys of the object to the top level of the log.",
+								},
+							},
+						},
+						"rename_field": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Renames a field of the log.",
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Required:    true,
+									Description: "Current name of the field.",
+								},
+								"target_field": schema.StringAttribute{
+									Required:    true,
+									Description: "New name of the field.",
+								},
+								"overwrite": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+									Description: "Whether to replace an existing field named target_field. " +
+										"If false, the field is not renamed when target_field exists. Defaults to false.",
+									Default: booldefault.StaticBool(false),
+								},
+							},
+						},
+						"remove_field": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Removes fields from the log.",
+							Attributes: map[string]schema.Attribute{
+								"fields": schema.ListAttribute{
+									Required:    true,
+									ElementType: types.StringType,
+									Description: "Names of the fields to remove.",
+									Validators: []validator.List{
+										validatorutils.NewListLengthAtLeastValidator(1),
+									},
+								},
+							},
+						},
+						"add_attribute": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Adds a field with a static value to the log.",
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Required:    true,
+									Description: "Name of the field to add.",
+								},
+								"value": schema.StringAttribute{
+									Required:    true,
+									Description: "Value of the field.",
+								},
+								"overwrite": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+									Description: "Whether to replace the value of an existing field. " +
+										"If false, logs that already have the field are left unchanged. Defaults to false.",
+									Default: booldefault.StaticBool(false),
+								},
+							},
+						},
+						"redact": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Replaces the parts of fields matching a regex, e.g. to mask personally identifiable information.",
+							Attributes: map[string]schema.Attribute{
+								"fields": schema.ListAttribute{
+									Required:    true,
+									ElementType: types.StringType,
+									Description: "Names of the fields to redact.",
+									Validators: []validator.List{
+										validatorutils.NewListLengthAtLeastValidator(1),
+									},
+								},
+								"regex": schema.StringAttribute{
+									Required:    true,
+									Description: "Regex of the values to redact. Must be a valid Rust regex.",
+									Validators: []validator.String{
+										validatorutils.NewRustRegexValidator(),
+									},
+								},
+								"replacement": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Description: "Replacement for each match. May reference capture groups as `$1` or `${name}`. " +
+										"Defaults to `[REDACTED]`.",
+									Default: stringdefault.StaticString(defaultRedactReplacement),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,258 @@
+package logpipeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type logPipelineResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID         types.String     `tfsdk:"id"`
+	Name       types.String     `tfsdk:"name"`
+	Processors []processorModel `tfsdk:"processors"`
+}
+
+type processorModel struct {
+	Name         types.String          `tfsdk:"name"`
+	Filter       *logfiltermodel.Model `tfsdk:"filter"`
+	GrokParser   *grokParserModel      `tfsdk:"grok_parser"`
+	RegexParser  *regexParserModel     `tfsdk:"regex_parser"`
+	JSONParser   *jsonParserModel      `tfsdk:"json_parser"`
+	RenameField  *renameFieldModel     `tfsdk:"rename_field"`
+	RemoveField  *removeFieldModel     `tfsdk:"remove_field"`
+	AddAttribute *addAttributeModel    `tfsdk:"add_attribute"`
+	Redact       *redactModel          `tfsdk:"redact"`
+}
+
+type grokParserModel struct {
+	Field              types.String            `tfsdk:"field"`
+	Patterns           []types.String          `tfsdk:"patterns"`
+	PatternDefinitions map[string]types.String `tfsdk:"pattern_definitions"`
+}
+
+type regexParserModel struct {
+	Field types.String `tfsdk:"field"`
+	Regex types.String `tfsdk:"regex"`
+}
+
+type jsonParserModel struct {
+	Field       types.String `tfsdk:"field"`
+	TargetField types.String `tfsdk:"target_field"`
+}
+
+type renameFieldModel struct {
+	Field       types.String `tfsdk:"field"`
+	TargetField types.String `tfsdk:"target_field"`
+	Overwrite   types.Bool   `tfsdk:"overwrite"`
+}
+
+type removeFieldModel struct {
+	Fields []types.String `tfsdk:"fields"`
+}
+
+type addAttributeModel struct {
+	Field     types.String `tfsdk:"field"`
+	Value     types.String `tfsdk:"value"`
+	Overwrite types.Bool   `tfsdk:"overwrite"`
+}
+
+type redactModel struct {
+	Fields      []types.String `tfsdk:"fields"`
+	Regex       types.String   `tfsdk:"regex"`
+	Replacement types.String   `tfsdk:"replacement"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.LogPipeline] = (*logPipelineResourceModel)(nil)
+
+func (m *logPipelineResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *logPipelineResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *logPipelineResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.LogPipeline,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data
+	*m = logPipelineResourceModel{}
+
+	m.ID = types.StringValue(model.ID.UUID.String())
+	m.Name = types.StringValue(model.Name)
+
+	m.Processors = make([]processorModel, len(model.Processors))
+	for i, p := range model.Processors {
+		m.Processors[i] = fromClientModelProcessor(p)
+	}
+}
+
+func fromClientModelProcessor(p *clientmodels.LogProcessor) processorModel {
+	res := processorModel{}
+	if p.Name != "" {
+		res.Name = types.StringValue(p.Name)
+	}
+	if p.Filter != nil {
+		res.Filter = logfiltermodel.FromClientModel(p.Filter)
+	}
+
+	switch {
+	case p.GrokParser != nil:
+		res.GrokParser = &grokParserModel{
+			Field:    types.StringValue(p.GrokParser.Field),
+			Patterns: fromStrings(p.GrokParser.Patterns),
+		}
+		if len(p.GrokParser.PatternDefinitions) > 0 {
+			res.GrokParser.PatternDefinitions = make(map[string]types.String, len(p.GrokParser.PatternDefinitions))
+			for name, pattern := range p.GrokParser.PatternDefinitions {
+				res.GrokParser.PatternDefinitions[name] = types.StringValue(pattern)
+			}
+		}
+	case p.RegexParser != nil:
+		res.RegexParser = &regexParserModel{
+			Field: types.StringValue(p.RegexParser.Field),
+			Regex: types.StringValue(p.RegexParser.Regex),
+		}
+	case p.JSONParser != nil:
+		res.JSONParser = &jsonParserModel{
+			Field: types.StringValue(p.JSONParser.Field),
+		}
+		if p.JSONParser.TargetField != "" {
+			res.JSONParser.TargetField = types.StringValue(p.JSONParser.TargetField)
+		}
+	case p.RenameField != nil:
+		res.RenameField = &renameFieldModel{
+			Field:       types.StringValue(p.RenameField.Field),
+			TargetField: types.StringValue(p.RenameField.TargetField),
+			Overwrite:   types.BoolValue(p.RenameField.Overwrite),
+		}
+	case p.RemoveField != nil:
+		res.RemoveField = &removeFieldModel{
+			Fields: fromStrings(p.RemoveField.Fields),
+		}
+	case p.AddAttribute != nil:
+		res.AddAttribute = &addAttributeModel{
+			Field:     types.StringValue(p.AddAttribute.Field),
+			Value:     types.StringValue(p.AddAttribute.Value),
+			Overwrite: types.BoolValue(p.AddAttribute.Overwrite),
+		}
+	case p.Redact != nil:
+		res.Redact = &redactModel{
+			Fields:      fromStrings(p.Redact.Fields),
+			Regex:       types.StringValue(p.Redact.Regex),
+			Replacement: types.StringValue(defaultRedactReplacement),
+		}
+		if p.Redact.Replacement != "" {
+			res.Redact.Replacement = types.StringValue(p.Redact.Replacement)
+		}
+	}
+	return res
+}
+
+func (m *logPipelineResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.LogPipeline,
+) error {
+	var err error
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID.UUID, err = uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse ID UUID %v: %v", m.ID.ValueString(), err)
+		}
+	}
+
+	model.Name = m.Name.ValueString()
+
+	model.Processors = make([]*clientmodels.LogProcessor, len(m.Processors))
+	for i, p := range m.Processors {
+		model.Processors[i] = p.toClientModel()
+	}
+	return nil
+}
+
+func (p processorModel) toClientModel() *clientmodels.LogProcessor {
+	res := &clientmodels.LogProcessor{
+		Name: p.Name.ValueString(),
+	}
+	if p.Filter != nil {
+		res.Filter = p.Filter.ToClientModel()
+	}
+
+	switch {
+	case p.GrokParser != nil:
+		res.GrokParser = &clientmodels.GrokParser{
+			Field:    p.GrokParser.Field.ValueString(),
+			Patterns: toStrings(p.GrokParser.Patterns),
+		}
+		if len(p.GrokParser.PatternDefinitions) > 0 {
+			res.GrokParser.PatternDefinitions = make(map[string]string, len(p.GrokParser.PatternDefinitions))
+			for name, pattern := range p.GrokParser.PatternDefinitions {
+				res.GrokParser.PatternDefinitions[name] = pattern.ValueString()
+			}
+		}
+	case p.RegexParser != nil:
+		res.RegexParser = &clientmodels.RegexParser{
+			Field: p.RegexParser.Field.ValueString(),
+			Regex: p.RegexParser.Regex.ValueString(),
+		}
+	case p.JSONParser != nil:
+		res.JSONParser = &clientmodels.JSONParser{
+			Field:       p.JSONParser.Field.ValueString(),
+			TargetField: p.JSONParser.TargetField.ValueString(),
+		}
+	case p.RenameField != nil:
+		res.RenameField = &clientmodels.RenameField{
+			Field:       p.RenameField.Field.ValueString(),
+			TargetField: p.RenameField.TargetField.ValueString(),
+			Overwrite:   p.RenameField.Overwrite.ValueBool(),
+		}
+	case p.RemoveField != nil:
+		res.RemoveField = &clientmodels.RemoveField{
+			Fields: toStrings(p.RemoveField.Fields),
+		}
+	case p.AddAttribute != nil:
+		res.AddAttribute = &clientmodels.AddAttribute{
+			Field:     p.AddAttribute.Field.ValueString(),
+			Value:     p.AddAttribute.Value.ValueString(),
+			Overwrite: p.AddAttribute.Overwrite.ValueBool(),
+		}
+	case p.Redact != nil:
+		res.Redact = &clientmodels.Redact{
+			Fields:      toStrings(p.Redact.Fields),
+			Regex:       p.Redact.Regex.ValueString(),
+			Replacement: p.Redact.Replacement.ValueString(),
+		}
+	}
+	return res
+}
+
+// defaultRedactReplacement is what the server replaces redacted values with
+// when no replacement is set.
+const defaultRedactReplacement = "[REDACTED]"
+
+func fromStrings(values []string) []types.String {
+	res := make([]types.String, len(values))
+	for i, v := range values {
+		res[i] = types.StringValue(v)
+	}
+	return res
+}
+
+func toStrings(values []types.String) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = v.ValueString()
+	}
+	return res
+}
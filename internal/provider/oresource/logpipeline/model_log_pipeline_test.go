@@ -0,0 +1,96 @@
+package logpipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestLogPipelineModel(t *testing.T) {
+	ctx := context.Background()
+
+	clientModel := &clientmodels.LogPipeline{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "checkout",
+		Processors: []*clientmodels.LogProcessor{
+			{
+				Name: "parse access logs",
+				Filter: &clientmodels.LogFilter{
+					Match: &clientmodels.Match{
+						Field:    "service",
+						Operator: clientmodels.IsOperator,
+						Value:    "nginx",
+					},
+				},
+				GrokParser: &clientmodels.GrokParser{
+					Field:              "msg",
+					Patterns:           []string{"%{IP:client} %{WORD:method} %{PATH:path} %{STATUS:status}"},
+					PatternDefinitions: map[string]string{"STATUS": `\d{3}`},
+				},
+			},
+			{
+				RegexParser: &clientmodels.RegexParser{
+					Field: "msg",
+					Regex: `took (?P<duration_ms>\d+)ms`,
+				},
+			},
+			{
+				JSONParser: &clientmodels.JSONParser{
+					Field:       "body",
+					TargetField: "payload",
+				},
+			},
+			{
+				RenameField: &clientmodels.RenameField{
+					Field:       "lvl",
+					TargetField: "level",
+					Overwrite:   true,
+				},
+			},
+			{
+				RemoveField: &clientmodels.RemoveField{
+					Fields: []string{"debug_context", "stack"},
+				},
+			},
+			{
+				AddAttribute: &clientmodels.AddAttribute{
+					Field: "team",
+					Value: "payments",
+				},
+			},
+			{
+				Filter: &clientmodels.LogFilter{
+					MatchNot: &clientmodels.MatchNot{
+						Not: &clientmodels.LogFilter{
+							Match: &clientmodels.Match{
+								Field:    "env",
+								Operator: clientmodels.IsOperator,
+								Value:    "dev",
+							},
+						},
+					},
+				},
+				Redact: &clientmodels.Redact{
+					Fields:      []string{"msg"},
+					Regex:       `[\w.+-]+@[\w-]+\.[\w.]+`,
+					Replacement: "<email>",
+				},
+			},
+		},
+	}
+
+	resourceModel := &logPipelineResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.LogPipeline{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
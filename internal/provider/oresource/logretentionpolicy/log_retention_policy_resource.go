@@ -0,0 +1,101 @@
+package logretentionpolicy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &logRetentionPolicyResource{}
+	_ resource.ResourceWithConfigure   = &logRetentionPolicyResource{}
+	_ resource.ResourceWithImportState = &logRetentionPolicyResource{}
+)
+
+const logRetentionPoliciesResourcePath = "log-retention-policies"
+
+// logRetentionPolicyResource is the resource implementation.
+type logRetentionPolicyResource struct {
+	oresource.BaseResource[*clientmodels.LogRetentionPolicy, *logRetentionPolicyResourceModel]
+}
+
+func NewLogRetentionPolicyResource() resource.Resource {
+	modelCreator := func() *clientmodels.LogRetentionPolicy {
+		return &clientmodels.LogRetentionPolicy{}
+	}
+	return &logRetentionPolicyResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.LogRetentionPolicy, *logRetentionPolicyResourceModel](
+			func() *logRetentionPolicyResourceModel {
+				return &logRetentionPolicyResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.LogRetentionPolicy] {
+				return oodlehttp.NewModelClient[*clientmodels.LogRetentionPolicy](
+					oodleHttpClient,
+					logRetentionPoliciesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *logRetentionPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_retention_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *logRetentionPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a log retention policy. Policies are evaluated in priority order and the first policy " +
+			"whose filter matches a log sets how long the log is kept.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the log retention policy.",
+				Validators: []validator.String{
+					validatorutils.NewUUIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the log retention policy.",
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional:   true,
+				Attributes: logfiltermodel.Schema(),
+				Description: "Filter to select the logs the policy applies to. Defaults to all logs, " +
+					"which makes the policy a fallback when it has the highest priority value.",
+				Validators: []validator.Object{
+					validatorutils.NewFilterValidator(),
+				},
+			},
+			"retention_days": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of days matching logs are kept. Must be at least 1.",
+				Validators: []validator.Int64{
+					validatorutils.NewInt64AtLeastValidator(1),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Required: true,
+				Description: "Order in which policies are evaluated, lowest first. The first matching policy applies. " +
+					"Must be at least 0.",
+				Validators: []validator.Int64{
+					validatorutils.NewInt64AtLeastValidator(0),
+				},
+			},
+		},
+	}
+}
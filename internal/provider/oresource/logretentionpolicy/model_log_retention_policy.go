@@ -0,0 +1,71 @@
+package logretentionpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type logRetentionPolicyResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID            types.String          `tfsdk:"id"`
+	Name          types.String          `tfsdk:"name"`
+	Filter        *logfiltermodel.Model `tfsdk:"filter"`
+	RetentionDays types.Int64           `tfsdk:"retention_days"`
+	Priority      types.Int64           `tfsdk:"priority"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.LogRetentionPolicy] = (*logRetentionPolicyResourceModel)(nil)
+
+func (m *logRetentionPolicyResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *logRetentionPolicyResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *logRetentionPolicyResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.LogRetentionPolicy,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data
+	*m = logRetentionPolicyResourceModel{}
+
+	m.ID = types.StringValue(model.ID.UUID.String())
+	m.Name = types.StringValue(model.Name)
+	if model.Filter != nil {
+		m.Filter = logfiltermodel.FromClientModel(model.Filter)
+	}
+	m.RetentionDays = types.Int64Value(model.RetentionDays)
+	m.Priority = types.Int64Value(model.Priority)
+}
+
+func (m *logRetentionPolicyResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.LogRetentionPolicy,
+) error {
+	var err error
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID.UUID, err = uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse ID UUID %v: %v", m.ID.ValueString(), err)
+		}
+	}
+	model.Name = m.Name.ValueString()
+	if m.Filter != nil {
+		model.Filter = m.Filter.ToClientModel()
+	}
+	model.RetentionDays = m.RetentionDays.ValueInt64()
+	model.Priority = m.Priority.ValueInt64()
+	return nil
+}
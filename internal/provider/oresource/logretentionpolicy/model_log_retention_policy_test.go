@@ -0,0 +1,61 @@
+package logretentionpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestLogRetentionPolicyModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogRetentionPolicy{
+		ID:   clientmodels.ID{UUID: uuid.New()},
+		Name: "audit logs",
+		Filter: &clientmodels.LogFilter{
+			Match: &clientmodels.Match{
+				Field:    "log_type",
+				Operator: clientmodels.IsOperator,
+				Value:    "audit",
+			},
+		},
+		RetentionDays: 365,
+		Priority:      0,
+	}
+
+	resourceModel := &logRetentionPolicyResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.Priority.ValueInt64(), int64(0))
+
+	newClientModel := &clientmodels.LogRetentionPolicy{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestLogRetentionPolicyModelNoFilter(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogRetentionPolicy{
+		ID:            clientmodels.ID{UUID: uuid.New()},
+		Name:          "default",
+		RetentionDays: 7,
+		Priority:      100,
+	}
+
+	resourceModel := &logRetentionPolicyResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, resourceModel.Filter == nil)
+
+	newClientModel := &clientmodels.LogRetentionPolicy{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
@@ -0,0 +1,141 @@
+package logview
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &logViewResource{}
+	_ resource.ResourceWithConfigure        = &logViewResource{}
+	_ resource.ResourceWithImportState      = &logViewResource{}
+	_ resource.ResourceWithConfigValidators = &logViewResource{}
+)
+
+const logViewsResourcePath = "log-views"
+
+var validSharing = map[string]struct{}{
+	string(clientmodels.PrivateLogViewSharing):  {},
+	string(clientmodels.InstanceLogViewSharing): {},
+}
+
+// logViewResource is the resource implementation.
+type logViewResource struct {
+	oresource.BaseResource[*clientmodels.LogView, *logViewResourceModel]
+}
+
+func NewLogViewResource() resource.Resource {
+	modelCreator := func() *clientmodels.LogView {
+		return &clientmodels.LogView{}
+	}
+	return &logViewResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.LogView, *logViewResourceModel](
+			func() *logViewResourceModel {
+				return &logViewResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.LogView] {
+				return oodlehttp.NewModelClient[*clientmodels.LogView](
+					oodleHttpClient,
+					logViewsResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *logViewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_view"
+}
+
+// ConfigValidators returns the resource-level validators.
+func (r *logViewResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewConflictingAttributesValidator(
+			path.Root("filter"),
+			path.Root("filter_query"),
+		),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *logViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a saved log view. A view stores a log search, the columns it shows and its time range, " +
+			"so that it can be shared and linked to by ID, e.g. from the annotations of a monitor.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the log view.",
+				Validators: []validator.String{
+					validatorutils.NewUUIDValidator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the log view.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the log view.",
+			},
+			"filter": schema.SingleNestedAttribute{
+				Optional:    true,
+				Attributes:  logfiltermodel.Schema(),
+				Description: "Filter to select the logs shown by the view. Cannot be used together with filter_query.",
+				Validators: []validator.Object{
+					validatorutils.NewFilterValidator(),
+				},
+			},
+			"filter_query": schema.StringAttribute{
+				Optional:   true,
+				CustomType: validatorutils.NewLogFilterQueryType(),
+				Description: "Filter to select the logs shown by the view, written as a query such as " +
+					"`service:checkout AND level:error`. Uses the same syntax as the filter_query of `oodle_logmetrics`. " +
+					"Cannot be used together with filter. Defaults to all logs when neither is set.",
+				Validators: []validator.String{
+					validatorutils.NewLogFilterQueryValidator(),
+				},
+			},
+			"columns": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Log fields shown as columns, in order. Defaults to the default columns of the log explorer.",
+			},
+			"time_range": schema.StringAttribute{
+				Required:   true,
+				CustomType: validatorutils.NewDurationType(),
+				Validators: []validator.String{
+					validatorutils.NewDurationValidator(),
+				},
+				Description: "Time range of the view, relative to when it is opened, e.g. `1h`.",
+			},
+			"sharing": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  validatorutils.NewDefaultString(types.StringValue(string(clientmodels.InstanceLogViewSharing))),
+				Description: "Who can see the view. Possible values are:\n" +
+					"  - `instance` - All users of the instance. This is the default.\n" +
+					"  - `private` - Only the owner of the API key that created the view.",
+				Validators: []validator.String{
+					validatorutils.NewChoiceValidator(validSharing),
+				},
+			},
+		},
+	}
+}
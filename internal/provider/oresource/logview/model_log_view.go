@@ -0,0 +1,110 @@
+package logview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/logfilter"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/logfiltermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+type logViewResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID          types.String                       `tfsdk:"id"`
+	Name        types.String                       `tfsdk:"name"`
+	Description types.String                       `tfsdk:"description"`
+	Filter      *logfiltermodel.Model              `tfsdk:"filter"`
+	FilterQuery validatorutils.LogFilterQueryValue `tfsdk:"filter_query"`
+	Columns     []types.String                     `tfsdk:"columns"`
+	TimeRange   validatorutils.DurationValue       `tfsdk:"time_range"`
+	Sharing     types.String                       `tfsdk:"sharing"`
+
+	// configuredFilterQuery is the filter_query the resource was planned or
+	// read with, as passed to PreserveConfigured.
+	configuredFilterQuery validatorutils.LogFilterQueryValue
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.LogView] = (*logViewResourceModel)(nil)
+var _ resourceutils.ConfiguredFormPreserver[*logViewResourceModel] = (*logViewResourceModel)(nil)
+
+func (m *logViewResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *logViewResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+// PreserveConfigured keeps the filter in the form prior was configured in.
+func (m *logViewResourceModel) PreserveConfigured(prior *logViewResourceModel) {
+	m.configuredFilterQuery = prior.FilterQuery
+}
+
+func (m *logViewResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.LogView,
+	diags *diag.Diagnostics,
+) {
+	// Keep the filter in the form it was configured in.
+	configuredQuery := m.configuredFilterQuery
+
+	// Reset the model to clear any existing data
+	*m = logViewResourceModel{}
+
+	m.ID = types.StringValue(model.ID.UUID.String())
+	m.Name = types.StringValue(model.Name)
+	if model.Description != "" {
+		m.Description = types.StringValue(model.Description)
+	}
+
+	m.Filter, m.FilterQuery = logfiltermodel.FromClientModelAs(model.Filter, configuredQuery, diags)
+
+	for _, column := range model.Columns {
+		m.Columns = append(m.Columns, types.StringValue(column))
+	}
+	if model.TimeRange != "" {
+		m.TimeRange = validatorutils.NewDurationValue(model.TimeRange)
+	}
+	m.Sharing = types.StringValue(string(model.Sharing))
+}
+
+func (m *logViewResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.LogView,
+) error {
+	var err error
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID.UUID, err = uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse ID UUID %v: %v", m.ID.ValueString(), err)
+		}
+	}
+
+	model.Name = m.Name.ValueString()
+	model.Description = m.Description.ValueString()
+
+	if !m.FilterQuery.IsNull() && !m.FilterQuery.IsUnknown() {
+		model.Filter, err = logfilter.Parse(m.FilterQuery.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse filter_query: %v", err)
+		}
+	}
+	if m.Filter != nil {
+		model.Filter = m.Filter.ToClientModel()
+	}
+
+	for _, column := range m.Columns {
+		model.Columns = append(model.Columns, column.ValueString())
+	}
+	model.TimeRange = m.TimeRange.ValueString()
+	model.Sharing = clientmodels.LogViewSharing(m.Sharing.ValueString())
+	return nil
+}
@@ -0,0 +1,92 @@
+package logview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+func TestLogViewModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.LogView{
+		ID:          clientmodels.ID{UUID: uuid.New()},
+		Name:        "Checkout errors",
+		Description: "Errors of the checkout service in the last hour",
+		Filter: &clientmodels.LogFilter{
+			Match: &clientmodels.Match{
+				Field:    "level",
+				Operator: clientmodels.IsOperator,
+				Value:    "error",
+			},
+		},
+		Columns:   []string{"timestamp", "service", "msg"},
+		TimeRange: "1h",
+		Sharing:   clientmodels.InstanceLogViewSharing,
+	}
+
+	resourceModel := &logViewResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, resourceModel.FilterQuery.IsNull())
+
+	newClientModel := &clientmodels.LogView{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestLogViewModelFilterQuery(t *testing.T) {
+	ctx := context.Background()
+	resourceModel := &logViewResourceModel{
+		FilterQuery: validatorutils.NewLogFilterQueryValue(`service:checkout level:error`),
+		TimeRange:   validatorutils.NewDurationValue("15m"),
+	}
+
+	clientModel := &clientmodels.LogView{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, clientModel))
+	assert.Equal(t, len(clientModel.Filter.MatchAll.All), 2)
+
+	// The filter is read back as a query when it was configured as one.
+	diags := &diag.Diagnostics{}
+	stateModel := &logViewResourceModel{}
+	stateModel.PreserveConfigured(resourceModel)
+	stateModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, stateModel.Filter == nil)
+	assert.Equal(t, stateModel.FilterQuery.ValueString(), "service:checkout AND level:error")
+
+	// Imported views use the structured filter.
+	importedModel := &logViewResourceModel{}
+	importedModel.FromClientModel(ctx, clientModel, diags)
+	assert.True(t, importedModel.FilterQuery.IsNull())
+	assert.Equal(t, len(importedModel.Filter.All), 2)
+}
+
+func TestLogViewModelUnformattableFilterQuery(t *testing.T) {
+	ctx := context.Background()
+	configured := &logViewResourceModel{
+		FilterQuery: validatorutils.NewLogFilterQueryValue(`service:checkout`),
+	}
+	clientModel := &clientmodels.LogView{
+		Filter: &clientmodels.LogFilter{
+			Match: &clientmodels.Match{Field: "service", Operator: "unknown", Value: "checkout"},
+		},
+	}
+
+	// A filter that cannot be formatted keeps the configured filter_query.
+	diags := &diag.Diagnostics{}
+	stateModel := &logViewResourceModel{}
+	stateModel.PreserveConfigured(configured)
+	stateModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, diags.WarningsCount(), 1)
+	assert.True(t, stateModel.Filter == nil)
+	assert.Equal(t, stateModel.FilterQuery.ValueString(), `service:checkout`)
+}
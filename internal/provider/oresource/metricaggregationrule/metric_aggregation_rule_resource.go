@@ -0,0 +1,145 @@
+package metricaggregationrule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &metricAggregationRuleResource{}
+	_ resource.ResourceWithConfigure        = &metricAggregationRuleResource{}
+	_ resource.ResourceWithImportState      = &metricAggregationRuleResource{}
+	_ resource.ResourceWithConfigValidators = &metricAggregationRuleResource{}
+)
+
+const aggregationRulesResourcePath = "aggregation-rules"
+
+var validAggregations = map[string]struct{}{
+	string(clientmodels.SumMetricAggregation):            {},
+	string(clientmodels.MinMetricAggregation):            {},
+	string(clientmodels.MaxMetricAggregation):            {},
+	string(clientmodels.CountMetricAggregation):          {},
+	string(clientmodels.HistogramMergeMetricAggregation): {},
+}
+
+// metricAggregationRuleResource is the resource implementation.
+type metricAggregationRuleResource struct {
+	oresource.BaseResource[*clientmodels.MetricAggregationRule, *metricAggregationRuleResourceModel]
+}
+
+func NewMetricAggregationRuleResource() resource.Resource {
+	modelCreator := func() *clientmodels.MetricAggregationRule {
+		return &clientmodels.MetricAggregationRule{}
+	}
+	return &metricAggregationRuleResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.MetricAggregationRule, *metricAggregationRuleResourceModel](
+			func() *metricAggregationRuleResourceModel {
+				return &metricAggregationRuleResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.MetricAggregationRule] {
+				return oodlehttp.NewModelClient[*clientmodels.MetricAggregationRule](
+					oodleHttpClient,
+					aggregationRulesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *metricAggregationRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_aggregation_rule"
+}
+
+// ConfigValidators returns the resource-level validators.
+func (r *metricAggregationRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewConflictingAttributesValidator(
+			path.Root("keep_labels"),
+			path.Root("drop_labels"),
+		),
+		validatorutils.NewMetricAggregationOutputValidator(),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *metricAggregationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a metric aggregation rule. Aggregation rules combine metric time-series at ingest time " +
+			"into a new metric with fewer labels, like a Prometheus recording rule, to reduce cardinality.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the metric aggregation rule.",
+			},
+			"rule_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Human-readable name for the aggregation rule.",
+			},
+			"metric_name": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  labelmatchermodel.Schema(),
+				Description: "The __name__ label matcher that selects which metrics to aggregate.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: labelmatchermodel.Schema(),
+				},
+				Description: "Optional additional label matchers that further restrict which series are aggregated.",
+			},
+			"keep_labels": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Labels kept on the output series. All other labels are aggregated away. " +
+					"Conflicts with `drop_labels`.",
+			},
+			"drop_labels": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Labels aggregated away. All other labels are kept on the output series. " +
+					"Conflicts with `keep_labels`.",
+			},
+			"aggregation": schema.StringAttribute{
+				Required: true,
+				Description: "How the matched series are combined. Possible values are:\n" +
+					"  - `sum` - Sum of the values.\n" +
+					"  - `min` - Minimum value.\n" +
+					"  - `max` - Maximum value.\n" +
+					"  - `count` - Number of series.\n" +
+					"  - `histogram_merge` - Merges histogram buckets, for histogram metrics.",
+				Validators: []validator.String{
+					validatorutils.NewChoiceValidator(validAggregations),
+				},
+			},
+			"output_metric_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the aggregated metric. Must be a valid Prometheus metric name.",
+				Validators: []validator.String{
+					validatorutils.NewPrometheusMetricNameValidator(),
+				},
+			},
+			"drop_raw_series": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to drop the matched series once they are aggregated, so that only the " +
+					"output metric is stored. Defaults to false.",
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package metricaggregationrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type metricAggregationRuleResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID               types.String              `tfsdk:"id"`
+	RuleName         types.String              `tfsdk:"rule_name"`
+	MetricName       *labelmatchermodel.Model  `tfsdk:"metric_name"`
+	Filters          []labelmatchermodel.Model `tfsdk:"filters"`
+	KeepLabels       []types.String            `tfsdk:"keep_labels"`
+	DropLabels       []types.String            `tfsdk:"drop_labels"`
+	Aggregation      types.String              `tfsdk:"aggregation"`
+	OutputMetricName types.String              `tfsdk:"output_metric_name"`
+	DropRawSeries    types.Bool                `tfsdk:"drop_raw_series"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.MetricAggregationRule] = (*metricAggregationRuleResourceModel)(nil)
+
+func (m *metricAggregationRuleResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *metricAggregationRuleResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *metricAggregationRuleResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.MetricAggregationRule,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data.
+	*m = metricAggregationRuleResourceModel{}
+
+	m.ID = types.StringValue(model.ID)
+	m.RuleName = types.StringValue(model.RuleName)
+	if model.MetricName != nil {
+		metricName := labelmatchermodel.FromClientModel(*model.MetricName)
+		m.MetricName = &metricName
+	}
+	m.Filters = labelmatchermodel.FromClientModels(model.Filters)
+	m.KeepLabels = fromStrings(model.KeepLabels)
+	m.DropLabels = fromStrings(model.DropLabels)
+	m.Aggregation = types.StringValue(string(model.Aggregation))
+	m.OutputMetricName = types.StringValue(model.OutputMetricName)
+	m.DropRawSeries = types.BoolValue(model.DropRawSeries)
+}
+
+func (m *metricAggregationRuleResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.MetricAggregationRule,
+) error {
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID = m.ID.ValueString()
+	}
+	model.RuleName = m.RuleName.ValueString()
+	if m.MetricName != nil {
+		metricName, err := m.MetricName.ToClientModel()
+		if err != nil {
+			return fmt.Errorf("failed to parse metric_name match type: %v", err)
+		}
+		model.MetricName = &metricName
+	}
+
+	filters, err := labelmatchermodel.ToClientModels(m.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to parse filter match type: %v", err)
+	}
+	model.Filters = filters
+
+	model.KeepLabels = toStrings(m.KeepLabels)
+	model.DropLabels = toStrings(m.DropLabels)
+	model.Aggregation = clientmodels.MetricAggregation(m.Aggregation.ValueString())
+	model.OutputMetricName = m.OutputMetricName.ValueString()
+	model.DropRawSeries = m.DropRawSeries.ValueBool()
+	return nil
+}
+
+func fromStrings(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make([]types.String, len(values))
+	for i, v := range values {
+		res[i] = types.StringValue(v)
+	}
+	return res
+}
+
+func toStrings(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = v.ValueString()
+	}
+	return res
+}
@@ -0,0 +1,76 @@
+package metricaggregationrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestMetricAggregationRuleModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.MetricAggregationRule{
+		ID:       "test-id-123",
+		RuleName: "Aggregate request latency by service",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchEqual,
+			Value: "http_request_duration_seconds",
+		},
+		Filters: []clientmodels.LabelMatcher{
+			{
+				Name:  "cluster",
+				Type:  amlabels.MatchNotEqual,
+				Value: "staging",
+			},
+		},
+		KeepLabels:       []string{"service", "status_code"},
+		Aggregation:      clientmodels.HistogramMergeMetricAggregation,
+		OutputMetricName: "service:http_request_duration_seconds",
+		DropRawSeries:    true,
+	}
+
+	resourceModel := &metricAggregationRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, resourceModel.DropLabels)
+
+	newClientModel := &clientmodels.MetricAggregationRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestMetricAggregationRuleModelDropLabels(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.MetricAggregationRule{
+		ID:       "test-id-456",
+		RuleName: "Sum requests without pod",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchRegexp,
+			Value: "http_requests_total|grpc_requests_total",
+		},
+		DropLabels:       []string{"pod", "instance"},
+		Aggregation:      clientmodels.SumMetricAggregation,
+		OutputMetricName: "requests:sum",
+	}
+
+	resourceModel := &metricAggregationRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, resourceModel.Filters)
+	assert.Equal(t, resourceModel.DropRawSeries, types.BoolValue(false))
+
+	newClientModel := &clientmodels.MetricAggregationRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
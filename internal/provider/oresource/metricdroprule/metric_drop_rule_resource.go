@@ -3,32 +3,29 @@ package metricdroprule
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
 	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
 	"terraform-provider-oodle/internal/validatorutils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &metricDropRuleResource{}
-	_ resource.ResourceWithConfigure   = &metricDropRuleResource{}
-	_ resource.ResourceWithImportState = &metricDropRuleResource{}
+	_ resource.Resource                     = &metricDropRuleResource{}
+	_ resource.ResourceWithConfigure        = &metricDropRuleResource{}
+	_ resource.ResourceWithImportState      = &metricDropRuleResource{}
+	_ resource.ResourceWithConfigValidators = &metricDropRuleResource{}
 )
 
 const dropRulesResourcePath = "drop-rules"
 
-var validMatchTypes = map[string]struct{}{
-	"=":  {},
-	"!=": {},
-	"=~": {},
-	"!~": {},
-}
-
 // metricDropRuleResource is the resource implementation.
 type metricDropRuleResource struct {
 	oresource.BaseResource[*clientmodels.MetricDropRule, *metricDropRuleResourceModel]
@@ -55,36 +52,35 @@ func NewMetricDropRuleResource() resource.Resource {
 	}
 }
 
-func matcherSchemaAttributes() map[string]schema.Attribute {
-	return map[string]schema.Attribute{
-		"name": schema.StringAttribute{
-			Required:    true,
-			Description: "Label name to match against.",
-		},
-		"type": schema.StringAttribute{
-			Required:    true,
-			Description: "Match type. Possible values are: '=' (exact), '!=' (not equal), '=~' (regex), '!~' (negative regex).",
-			Validators: []validator.String{
-				validatorutils.NewChoiceValidator(validMatchTypes),
-			},
-		},
-		"value": schema.StringAttribute{
-			Required:    true,
-			Description: "Value or pattern to match against.",
-		},
-	}
-}
-
 // Metadata returns the resource type name.
 func (r *metricDropRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_metric_drop_rule"
 }
 
+// ConfigValidators returns the resource-level validators.
+func (r *metricDropRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewConflictingAttributesValidator(
+			path.Root("metric_name"),
+			path.Root("selector"),
+		),
+		validatorutils.NewConflictingAttributesValidator(
+			path.Root("filters"),
+			path.Root("selector"),
+		),
+		validatorutils.NewAtLeastOneOfAttributesValidator(
+			path.Root("metric_name"),
+			path.Root("selector"),
+		),
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *metricDropRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a metric drop rule. Drop rules prevent specific metric time-series from being ingested into Oodle.",
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the metric drop rule.",
@@ -98,16 +94,37 @@ func (r *metricDropRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "Type of the drop rule. Use 'series' for dropping metric time-series.",
 			},
 			"metric_name": schema.SingleNestedAttribute{
-				Required:    true,
-				Attributes:  matcherSchemaAttributes(),
-				Description: "The __name__ label matcher that selects which metrics to drop.",
+				Optional:   true,
+				Attributes: labelmatchermodel.Schema(),
+				Description: "The __name__ label matcher that selects which metrics to drop. " +
+					"Required unless selector is set.",
 			},
 			"filters": schema.ListNestedAttribute{
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: matcherSchemaAttributes(),
+					Attributes: labelmatchermodel.Schema(),
+				},
+				Description: "Optional additional label matchers that further restrict which series are dropped. " +
+					"Cannot be used together with selector.",
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Whether to create the rule in the observe state, where it only measures the series it " +
+					"would drop without dropping them. The impact can be read with the " +
+					"oodle_metric_drop_rule_impact data source. Defaults to false.",
+				Default: booldefault.StaticBool(false),
+			},
+			"selector": schema.StringAttribute{
+				Optional:   true,
+				CustomType: validatorutils.NewMetricSelectorType(),
+				Description: "Series to drop, written as a PromQL series selector such as " +
+					"`http_requests_total{env=\"dev\",pod=~\"canary-.*\"}`. The metric name can also be " +
+					"matched in the braces, e.g. `{__name__=~\"go_gc_.*\"}`. Cannot be used together with " +
+					"metric_name and filters.",
+				Validators: []validator.String{
+					validatorutils.NewMetricSelectorValidator(),
 				},
-				Description: "Optional additional label matchers that further restrict which series are dropped.",
 			},
 		},
 	}
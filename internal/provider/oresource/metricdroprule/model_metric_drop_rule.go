@@ -6,24 +6,24 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	amlabels "github.com/prometheus/alertmanager/pkg/labels"
 
+	"terraform-provider-oodle/internal/metricselector"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
 	"terraform-provider-oodle/internal/resourceutils"
+	"terraform-provider-oodle/internal/validatorutils"
 )
 
 type metricDropRuleResourceModel struct {
-	ID         types.String           `tfsdk:"id"`
-	RuleName   types.String           `tfsdk:"rule_name"`
-	Type       types.String           `tfsdk:"type"`
-	MetricName *dropRuleMatcherModel  `tfsdk:"metric_name"`
-	Filters    []dropRuleMatcherModel `tfsdk:"filters"`
-}
-
-type dropRuleMatcherModel struct {
-	Name  types.String `tfsdk:"name"`
-	Type  types.String `tfsdk:"type"`
-	Value types.String `tfsdk:"value"`
+	resourceutils.InstanceModel
+
+	ID         types.String                       `tfsdk:"id"`
+	RuleName   types.String                       `tfsdk:"rule_name"`
+	Type       types.String                       `tfsdk:"type"`
+	MetricName *labelmatchermodel.Model           `tfsdk:"metric_name"`
+	Filters    []labelmatchermodel.Model          `tfsdk:"filters"`
+	Selector   validatorutils.MetricSelectorValue `tfsdk:"selector"`
+	DryRun     types.Bool                         `tfsdk:"dry_run"`
 }
 
 var _ resourceutils.ResourceModel[*clientmodels.MetricDropRule] = (*metricDropRuleResourceModel)(nil)
@@ -41,31 +41,26 @@ func (m *metricDropRuleResourceModel) FromClientModel(
 	model *clientmodels.MetricDropRule,
 	_ *diag.Diagnostics,
 ) {
+	// Keep the matchers in the form they were configured in.
+	useSelector := !m.Selector.IsNull()
+
 	// Reset the model to clear any existing data.
 	*m = metricDropRuleResourceModel{}
 
 	m.ID = types.StringValue(model.ID)
 	m.RuleName = types.StringValue(model.RuleName)
 	m.Type = types.StringValue(model.Type)
+	m.DryRun = types.BoolValue(model.State == clientmodels.ObserveMetricDropRuleState)
 
-	if model.MetricName != nil {
-		m.MetricName = &dropRuleMatcherModel{
-			Name:  types.StringValue(model.MetricName.Name),
-			Type:  types.StringValue(model.MetricName.Type.String()),
-			Value: types.StringValue(model.MetricName.Value),
-		}
+	if model.MetricName != nil && useSelector {
+		m.Selector = validatorutils.NewMetricSelectorValue(metricselector.Format(*model.MetricName, model.Filters))
+		return
 	}
-
-	if len(model.Filters) > 0 {
-		m.Filters = make([]dropRuleMatcherModel, len(model.Filters))
-		for i, filter := range model.Filters {
-			m.Filters[i] = dropRuleMatcherModel{
-				Name:  types.StringValue(filter.Name),
-				Type:  types.StringValue(filter.Type.String()),
-				Value: types.StringValue(filter.Value),
-			}
-		}
+	if model.MetricName != nil {
+		metricName := labelmatchermodel.FromClientModel(*model.MetricName)
+		m.MetricName = &metricName
 	}
+	m.Filters = labelmatchermodel.FromClientModels(model.Filters)
 }
 
 func (m *metricDropRuleResourceModel) ToClientModel(
@@ -78,48 +73,33 @@ func (m *metricDropRuleResourceModel) ToClientModel(
 
 	model.RuleName = m.RuleName.ValueString()
 	model.Type = m.Type.ValueString()
+	model.State = clientmodels.ActiveMetricDropRuleState
+	if m.DryRun.ValueBool() {
+		model.State = clientmodels.ObserveMetricDropRuleState
+	}
 
 	if m.MetricName != nil {
-		matchType, err := parseMatchType(m.MetricName.Type.ValueString())
+		metricName, err := m.MetricName.ToClientModel()
 		if err != nil {
 			return fmt.Errorf("failed to parse metric_name match type: %v", err)
 		}
-		model.MetricName = &clientmodels.LabelMatcher{
-			Name:  m.MetricName.Name.ValueString(),
-			Type:  matchType,
-			Value: m.MetricName.Value.ValueString(),
-		}
+		model.MetricName = &metricName
+	}
+
+	filters, err := labelmatchermodel.ToClientModels(m.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to parse filter match type: %v", err)
 	}
+	model.Filters = filters
 
-	if len(m.Filters) > 0 {
-		model.Filters = make([]clientmodels.LabelMatcher, len(m.Filters))
-		for i, filter := range m.Filters {
-			matchType, err := parseMatchType(filter.Type.ValueString())
-			if err != nil {
-				return fmt.Errorf("failed to parse filter match type: %v", err)
-			}
-			model.Filters[i] = clientmodels.LabelMatcher{
-				Name:  filter.Name.ValueString(),
-				Type:  matchType,
-				Value: filter.Value.ValueString(),
-			}
+	if !m.Selector.IsNull() && !m.Selector.IsUnknown() {
+		metricName, filters, err := metricselector.Parse(m.Selector.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse selector: %v", err)
 		}
+		model.MetricName = &metricName
+		model.Filters = filters
 	}
 
 	return nil
 }
-
-func parseMatchType(s string) (amlabels.MatchType, error) {
-	switch s {
-	case "=":
-		return amlabels.MatchEqual, nil
-	case "!=":
-		return amlabels.MatchNotEqual, nil
-	case "=~":
-		return amlabels.MatchRegexp, nil
-	case "!~":
-		return amlabels.MatchNotRegexp, nil
-	default:
-		return 0, fmt.Errorf("invalid match type: %s", s)
-	}
-}
@@ -5,10 +5,12 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	amlabels "github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/rubrikinc/testwell/assert"
 
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/validatorutils"
 )
 
 func TestMetricDropRuleModel(t *testing.T) {
@@ -17,6 +19,7 @@ func TestMetricDropRuleModel(t *testing.T) {
 		ID:       "test-id-123",
 		RuleName: "Drop unused go_gc metrics",
 		Type:     "series",
+		State:    clientmodels.ActiveMetricDropRuleState,
 		MetricName: &clientmodels.LabelMatcher{
 			Name:  "__name__",
 			Type:  amlabels.MatchRegexp,
@@ -53,6 +56,7 @@ func TestMetricDropRuleModelNoFilters(t *testing.T) {
 		ID:       "test-id-456",
 		RuleName: "Drop all kube_state metrics",
 		Type:     "series",
+		State:    clientmodels.ActiveMetricDropRuleState,
 		MetricName: &clientmodels.LabelMatcher{
 			Name:  "__name__",
 			Type:  amlabels.MatchEqual,
@@ -77,6 +81,7 @@ func TestMetricDropRuleModelAllMatchTypes(t *testing.T) {
 		ID:       "test-id-789",
 		RuleName: "Test all match types",
 		Type:     "series",
+		State:    clientmodels.ActiveMetricDropRuleState,
 		MetricName: &clientmodels.LabelMatcher{
 			Name:  "__name__",
 			Type:  amlabels.MatchNotRegexp,
@@ -116,3 +121,82 @@ func TestMetricDropRuleModelAllMatchTypes(t *testing.T) {
 
 	assert.DeepEqual(t, clientModel, newClientModel)
 }
+
+func TestMetricDropRuleModelSelector(t *testing.T) {
+	ctx := context.Background()
+	resourceModel := &metricDropRuleResourceModel{
+		ID:       types.StringValue("test-id-123"),
+		RuleName: types.StringValue("Drop canary requests"),
+		Type:     types.StringValue("series"),
+		Selector: validatorutils.NewMetricSelectorValue(`http_requests_total{env="dev",pod=~"canary-.*"}`),
+	}
+
+	clientModel := &clientmodels.MetricDropRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, clientModel))
+	assert.DeepEqual(t, clientModel, &clientmodels.MetricDropRule{
+		ID:       "test-id-123",
+		RuleName: "Drop canary requests",
+		Type:     "series",
+		State:    clientmodels.ActiveMetricDropRuleState,
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchEqual,
+			Value: "http_requests_total",
+		},
+		Filters: []clientmodels.LabelMatcher{
+			{Name: "env", Type: amlabels.MatchEqual, Value: "dev"},
+			{Name: "pod", Type: amlabels.MatchRegexp, Value: "canary-.*"},
+		},
+	})
+
+	// Read keeps the selector form, normalized.
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, resourceModel.MetricName)
+	assert.Nil(t, resourceModel.Filters)
+	assert.Equal(t, resourceModel.Selector.ValueString(), `http_requests_total{env="dev", pod=~"canary-.*"}`)
+
+	newClientModel := &clientmodels.MetricDropRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestMetricDropRuleModelInvalidSelector(t *testing.T) {
+	resourceModel := &metricDropRuleResourceModel{
+		RuleName: types.StringValue("Drop dev series"),
+		Type:     types.StringValue("series"),
+		Selector: validatorutils.NewMetricSelectorValue(`{env="dev"}`),
+	}
+
+	err := resourceModel.ToClientModel(context.Background(), &clientmodels.MetricDropRule{})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	assert.Equal(t, err.Error(), `failed to parse selector: invalid selector "{env=\"dev\"}": a metric name or a __name__ matcher is required`)
+}
+
+func TestMetricDropRuleModelDryRun(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.MetricDropRule{
+		ID:       "test-id-321",
+		RuleName: "Observe dropping go_gc metrics",
+		Type:     "series",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchRegexp,
+			Value: "go_gc_.*",
+		},
+		State: clientmodels.ObserveMetricDropRuleState,
+	}
+
+	resourceModel := &metricDropRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.DryRun, types.BoolValue(true))
+
+	newClientModel := &clientmodels.MetricDropRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
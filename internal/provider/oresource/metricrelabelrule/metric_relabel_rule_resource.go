@@ -0,0 +1,173 @@
+package metricrelabelrule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &metricRelabelRuleResource{}
+	_ resource.ResourceWithConfigure        = &metricRelabelRuleResource{}
+	_ resource.ResourceWithImportState      = &metricRelabelRuleResource{}
+	_ resource.ResourceWithConfigValidators = &metricRelabelRuleResource{}
+)
+
+const relabelRulesResourcePath = "relabel-rules"
+
+var validActions = map[string]struct{}{
+	string(clientmodels.ReplaceRelabelAction):   {},
+	string(clientmodels.KeepRelabelAction):      {},
+	string(clientmodels.DropRelabelAction):      {},
+	string(clientmodels.KeepEqualRelabelAction): {},
+	string(clientmodels.DropEqualRelabelAction): {},
+	string(clientmodels.HashModRelabelAction):   {},
+	string(clientmodels.LabelMapRelabelAction):  {},
+	string(clientmodels.LabelDropRelabelAction): {},
+	string(clientmodels.LabelKeepRelabelAction): {},
+	string(clientmodels.LowercaseRelabelAction): {},
+	string(clientmodels.UppercaseRelabelAction): {},
+}
+
+// metricRelabelRuleResource is the resource implementation.
+type metricRelabelRuleResource struct {
+	oresource.BaseResource[*clientmodels.MetricRelabelRule, *metricRelabelRuleResourceModel]
+}
+
+func NewMetricRelabelRuleResource() resource.Resource {
+	modelCreator := func() *clientmodels.MetricRelabelRule {
+		return &clientmodels.MetricRelabelRule{}
+	}
+	return &metricRelabelRuleResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.MetricRelabelRule, *metricRelabelRuleResourceModel](
+			func() *metricRelabelRuleResourceModel {
+				return &metricRelabelRuleResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.MetricRelabelRule] {
+				return oodlehttp.NewModelClient[*clientmodels.MetricRelabelRule](
+					oodleHttpClient,
+					relabelRulesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *metricRelabelRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_relabel_rule"
+}
+
+// ConfigValidators returns the validators that need the whole configuration.
+func (r *metricRelabelRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validatorutils.NewMetricRelabelRuleValidator(),
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *metricRelabelRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a metric relabel rule. Relabel rules rewrite the labels of metric time-series at " +
+			"ingest time, with the semantics of a Prometheus `metric_relabel_configs` entry.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the metric relabel rule.",
+			},
+			"rule_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Human-readable name for the relabel rule.",
+			},
+			"metric_name": schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  labelmatchermodel.Schema(),
+				Description: "The __name__ label matcher that selects which metrics to relabel.",
+			},
+			"filters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: labelmatchermodel.Schema(),
+				},
+				Description: "Optional additional label matchers that further restrict which series are relabeled.",
+			},
+			"source_labels": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Labels whose values are concatenated with separator and matched against regex.",
+				Validators: []validator.List{
+					validatorutils.NewListElementsValidator(validatorutils.NewLabelNameValidator()),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     validatorutils.NewDefaultString(types.StringValue(defaultSeparator)),
+				Description: "Separator placed between the concatenated source label values. Defaults to `;`.",
+			},
+			"regex": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  validatorutils.NewDefaultString(types.StringValue(defaultRegex)),
+				Description: "Regex matched against the concatenated source label values, or against label names " +
+					"for the `labelmap`, `labeldrop` and `labelkeep` actions. The regex is anchored at both ends. " +
+					"Defaults to `(.*)`.",
+				Validators: []validator.String{
+					validatorutils.NewPrometheusRegexValidator(),
+				},
+			},
+			"modulus": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Modulus of the hash of the source label values. Required for the `hashmod` action.",
+				Validators: []validator.Int64{
+					validatorutils.NewInt64AtLeastValidator(1),
+				},
+			},
+			"replacement": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  validatorutils.NewDefaultString(types.StringValue(defaultReplacement)),
+				Description: "Value written to target_label, in which regex capture groups can be referenced as " +
+					"`$1` or `${name}`. Defaults to `$1`.",
+			},
+			"target_label": schema.StringAttribute{
+				Optional: true,
+				Description: "Label the result is written to. Set it to `__name__` to rename metrics. Required for " +
+					"the `replace`, `hashmod`, `lowercase`, `uppercase`, `keepequal` and `dropequal` actions.",
+			},
+			"action": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  validatorutils.NewDefaultString(types.StringValue(string(defaultAction))),
+				Description: "Relabel action to perform. Possible values are:\n" +
+					"  - `replace` - Writes replacement to target_label if regex matches. This is the default.\n" +
+					"  - `keep` - Drops series for which regex does not match.\n" +
+					"  - `drop` - Drops series for which regex matches.\n" +
+					"  - `keepequal` - Drops series whose source label values do not equal target_label.\n" +
+					"  - `dropequal` - Drops series whose source label values equal target_label.\n" +
+					"  - `hashmod` - Writes the hash of the source label values modulo modulus to target_label.\n" +
+					"  - `labelmap` - Copies the labels whose name matches regex to the names given by replacement.\n" +
+					"  - `labeldrop` - Removes the labels whose name matches regex.\n" +
+					"  - `labelkeep` - Removes the labels whose name does not match regex.\n" +
+					"  - `lowercase` - Writes the lowercased source label values to target_label.\n" +
+					"  - `uppercase` - Writes the uppercased source label values to target_label.",
+				Validators: []validator.String{
+					validatorutils.NewChoiceValidator(validActions),
+				},
+			},
+		},
+	}
+}
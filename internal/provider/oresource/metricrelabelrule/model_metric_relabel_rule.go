@@ -0,0 +1,114 @@
+package metricrelabelrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+const (
+	defaultSeparator   = ";"
+	defaultRegex       = "(.*)"
+	defaultReplacement = "$1"
+	defaultAction      = clientmodels.ReplaceRelabelAction
+)
+
+type metricRelabelRuleResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID           types.String              `tfsdk:"id"`
+	RuleName     types.String              `tfsdk:"rule_name"`
+	MetricName   *labelmatchermodel.Model  `tfsdk:"metric_name"`
+	Filters      []labelmatchermodel.Model `tfsdk:"filters"`
+	SourceLabels []types.String            `tfsdk:"source_labels"`
+	Separator    types.String              `tfsdk:"separator"`
+	Regex        types.String              `tfsdk:"regex"`
+	Modulus      types.Int64               `tfsdk:"modulus"`
+	Replacement  types.String              `tfsdk:"replacement"`
+	TargetLabel  types.String              `tfsdk:"target_label"`
+	Action       types.String              `tfsdk:"action"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.MetricRelabelRule] = (*metricRelabelRuleResourceModel)(nil)
+
+func (m *metricRelabelRuleResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *metricRelabelRuleResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *metricRelabelRuleResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.MetricRelabelRule,
+	_ *diag.Diagnostics,
+) {
+	// Reset the model to clear any existing data.
+	*m = metricRelabelRuleResourceModel{}
+
+	m.ID = types.StringValue(model.ID)
+	m.RuleName = types.StringValue(model.RuleName)
+	if model.MetricName != nil {
+		metricName := labelmatchermodel.FromClientModel(*model.MetricName)
+		m.MetricName = &metricName
+	}
+	m.Filters = labelmatchermodel.FromClientModels(model.Filters)
+	if len(model.SourceLabels) > 0 {
+		m.SourceLabels = make([]types.String, len(model.SourceLabels))
+		for i, label := range model.SourceLabels {
+			m.SourceLabels[i] = types.StringValue(label)
+		}
+	}
+	m.Separator = types.StringValue(model.Separator)
+	m.Regex = types.StringValue(model.Regex)
+	if model.Modulus != 0 {
+		m.Modulus = types.Int64Value(int64(model.Modulus))
+	}
+	m.Replacement = types.StringValue(model.Replacement)
+	if model.TargetLabel != "" {
+		m.TargetLabel = types.StringValue(model.TargetLabel)
+	}
+	m.Action = types.StringValue(string(model.Action))
+}
+
+func (m *metricRelabelRuleResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.MetricRelabelRule,
+) error {
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID = m.ID.ValueString()
+	}
+	model.RuleName = m.RuleName.ValueString()
+	if m.MetricName != nil {
+		metricName, err := m.MetricName.ToClientModel()
+		if err != nil {
+			return fmt.Errorf("failed to parse metric_name match type: %v", err)
+		}
+		model.MetricName = &metricName
+	}
+
+	filters, err := labelmatchermodel.ToClientModels(m.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to parse filter match type: %v", err)
+	}
+	model.Filters = filters
+
+	model.SourceLabels = nil
+	for _, label := range m.SourceLabels {
+		model.SourceLabels = append(model.SourceLabels, label.ValueString())
+	}
+	model.Separator = m.Separator.ValueString()
+	model.Regex = m.Regex.ValueString()
+	model.Modulus = uint64(m.Modulus.ValueInt64())
+	model.Replacement = m.Replacement.ValueString()
+	model.TargetLabel = m.TargetLabel.ValueString()
+	model.Action = clientmodels.RelabelAction(m.Action.ValueString())
+	return nil
+}
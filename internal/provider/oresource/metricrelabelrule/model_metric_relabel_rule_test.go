@@ -0,0 +1,79 @@
+package metricrelabelrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestMetricRelabelRuleModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.MetricRelabelRule{
+		ID:       "test-id-123",
+		RuleName: "Shard requests by pod",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchEqual,
+			Value: "http_requests_total",
+		},
+		Filters: []clientmodels.LabelMatcher{
+			{
+				Name:  "env",
+				Type:  amlabels.MatchEqual,
+				Value: "prod",
+			},
+		},
+		SourceLabels: []string{"namespace", "pod"},
+		Separator:    "/",
+		Regex:        "(.*)",
+		Modulus:      8,
+		Replacement:  "$1",
+		TargetLabel:  "shard",
+		Action:       clientmodels.HashModRelabelAction,
+	}
+
+	resourceModel := &metricRelabelRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.MetricRelabelRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestMetricRelabelRuleModelLabelDrop(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.MetricRelabelRule{
+		ID:       "test-id-456",
+		RuleName: "Drop build labels",
+		MetricName: &clientmodels.LabelMatcher{
+			Name:  "__name__",
+			Type:  amlabels.MatchRegexp,
+			Value: "go_.*",
+		},
+		Separator:   ";",
+		Regex:       "build_.*",
+		Replacement: "$1",
+		Action:      clientmodels.LabelDropRelabelAction,
+	}
+
+	resourceModel := &metricRelabelRuleResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, resourceModel.SourceLabels)
+	assert.True(t, resourceModel.TargetLabel.IsNull())
+	assert.True(t, resourceModel.Modulus.IsNull())
+
+	newClientModel := &clientmodels.MetricRelabelRule{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
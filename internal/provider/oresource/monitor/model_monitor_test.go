@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/rubrikinc/testwell/assert"
 
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
@@ -80,3 +81,62 @@ func TestMonitorModel(t *testing.T) {
 
 	assert.DeepEqual(t, clientModel, newClientModel)
 }
+
+func TestMonitorModelNotifications(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.Monitor{
+		ID: clientmodels.ID{
+			UUID: uuid.New(),
+		},
+		Name:        "test",
+		PromQLQuery: "test2",
+		Interval:    1,
+		Conditions: clientmodels.ConditionBySeverity{
+			Critical: &clientmodels.Condition{
+				Op:    2,
+				Value: 2,
+			},
+		},
+		Notifications: []clientmodels.LabelMatcherNotifications{
+			{
+				Matchers: []clientmodels.LabelMatcher{
+					{
+						Type:  labels.MatchEqual,
+						Name:  "team",
+						Value: "infra",
+					},
+					{
+						Type:  labels.MatchNotRegexp,
+						Name:  "env",
+						Value: "dev.*",
+					},
+				},
+				Notifiers: clientmodels.NotifiersByCondition{
+					Warn: []clientmodels.ID{
+						{UUID: uuid.New()},
+					},
+					Critical: []clientmodels.ID{
+						{UUID: uuid.New()},
+						{UUID: uuid.New()},
+					},
+				},
+			},
+			{
+				Matchers: []clientmodels.LabelMatcher{},
+				NotificationPolicyID: clientmodels.ID{
+					UUID: uuid.New(),
+				},
+			},
+		},
+	}
+
+	resourceModel := &monitorResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+
+	newClientModel := &clientmodels.Monitor{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
@@ -14,6 +14,8 @@ import (
 )
 
 type notificationPolicyResourceModel struct {
+	resourceutils.InstanceModel
+
 	ID            types.String        `tfsdk:"id"`
 	Name          types.String        `tfsdk:"name"`
 	Notifiers     notifiersBySeverity `tfsdk:"notifiers"`
@@ -57,6 +57,7 @@ func (n *notificationPolicyResource) Metadata(_ context.Context, req resource.Me
 func (n *notificationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the notification policy.",
@@ -0,0 +1,182 @@
+// Package notificationsmodel holds the Terraform schema and model of
+// clientmodels.LabelMatcherNotifications, in the shape of the notifications of
+// oodle_monitor, for the resources that raise alerts of their own.
+package notificationsmodel
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Model is the Terraform model of a clientmodels.LabelMatcherNotifications.
+type Model struct {
+	Matchers             []labelmatchermodel.Model `tfsdk:"matchers"`
+	NotificationPolicyID types.String              `tfsdk:"notification_policy_id"`
+	Notifiers            *NotifiersModel           `tfsdk:"notifiers"`
+}
+
+// NotifiersModel is the Terraform model of a clientmodels.NotifiersByCondition.
+type NotifiersModel struct {
+	Any      types.List `tfsdk:"any"`
+	Warn     types.List `tfsdk:"warn"`
+	Critical types.List `tfsdk:"critical"`
+	NoData   types.List `tfsdk:"no_data"`
+}
+
+// Schema returns the attributes of a notification.
+func Schema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"matchers": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "List of label matchers that determine when this notification applies.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: labelmatchermodel.Schema(),
+			},
+		},
+		"notification_policy_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "ID of the notification policy to use when labels match. Either this or notifiers must be specified.",
+			Validators: []validator.String{
+				validatorutils.NewUUIDValidator(),
+			},
+		},
+		"notifiers": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "Notifiers by severity. Either this or notification_policy_id must be specified.",
+			Validators: []validator.Object{
+				validatorutils.NewNotifiersValidator(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"any": schema.ListAttribute{
+					Optional:    true,
+					Description: "Notifier IDs for any severity. If set, other severity-specific notifiers must be empty.",
+					ElementType: types.StringType,
+				},
+				"warn": schema.ListAttribute{
+					Optional:    true,
+					Description: "Notifier IDs for warning severity.",
+					ElementType: types.StringType,
+				},
+				"critical": schema.ListAttribute{
+					Optional:    true,
+					Description: "Notifier IDs for critical severity.",
+					ElementType: types.StringType,
+				},
+				"no_data": schema.ListAttribute{
+					Optional:    true,
+					Description: "Notifier IDs for no data scenarios.",
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// FromClientModels converts notifications received from oodle APIs. An empty
+// list is converted to nil, as optional lists are null when not configured.
+func FromClientModels(notifications []clientmodels.LabelMatcherNotifications, diags *diag.Diagnostics) []Model {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	res := make([]Model, len(notifications))
+	for i, notification := range notifications {
+		m := Model{
+			Matchers:             labelmatchermodel.FromClientModels(notification.Matchers),
+			NotificationPolicyID: types.StringNull(),
+		}
+		if notification.NotificationPolicyID.UUID != uuid.Nil {
+			m.NotificationPolicyID = types.StringValue(notification.NotificationPolicyID.UUID.String())
+		}
+
+		n := notification.Notifiers
+		if len(n.Any) > 0 || len(n.Warn) > 0 || len(n.Critical) > 0 || len(n.NoData) > 0 {
+			m.Notifiers = &NotifiersModel{
+				Any:      idsToAttrList(n.Any, diags),
+				Warn:     idsToAttrList(n.Warn, diags),
+				Critical: idsToAttrList(n.Critical, diags),
+				NoData:   idsToAttrList(n.NoData, diags),
+			}
+		}
+		res[i] = m
+	}
+	return res
+}
+
+// ToClientModels converts a list of Models to notifications to use in oodle
+// APIs.
+func ToClientModels(models []Model) ([]clientmodels.LabelMatcherNotifications, error) {
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	res := make([]clientmodels.LabelMatcherNotifications, len(models))
+	for i, m := range models {
+		// Matchers are always sent, as an empty list matches all alerts.
+		matchers, err := labelmatchermodel.ToClientModels(m.Matchers)
+		if err != nil {
+			return nil, err
+		}
+		if matchers == nil {
+			matchers = []clientmodels.LabelMatcher{}
+		}
+		notification := clientmodels.LabelMatcherNotifications{
+			Matchers: matchers,
+		}
+
+		if !m.NotificationPolicyID.IsNull() && !m.NotificationPolicyID.IsUnknown() {
+			uid, err := uuid.Parse(m.NotificationPolicyID.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse notification policy UUID: %v", err)
+			}
+			notification.NotificationPolicyID = clientmodels.ID{UUID: uid}
+		}
+
+		if m.Notifiers != nil {
+			if notification.Notifiers.Any, err = attrListToIDs("any", m.Notifiers.Any); err != nil {
+				return nil, err
+			}
+			if notification.Notifiers.Warn, err = attrListToIDs("warn", m.Notifiers.Warn); err != nil {
+				return nil, err
+			}
+			if notification.Notifiers.Critical, err = attrListToIDs("critical", m.Notifiers.Critical); err != nil {
+				return nil, err
+			}
+			if notification.Notifiers.NoData, err = attrListToIDs("no_data", m.Notifiers.NoData); err != nil {
+				return nil, err
+			}
+		}
+		res[i] = notification
+	}
+	return res, nil
+}
+
+// idsToAttrList converts notifier IDs, leaving the list null when empty.
+func idsToAttrList(ids []clientmodels.ID, diags *diag.Diagnostics) types.List {
+	if len(ids) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	return validatorutils.IDsToAttrList(ids, diags)
+}
+
+// attrListToIDs parses the notifier IDs of a severity, returning nil when the
+// list is not set.
+func attrListToIDs(severity string, values types.List) ([]clientmodels.ID, error) {
+	if values.IsNull() || values.IsUnknown() || len(values.Elements()) == 0 {
+		return nil, nil
+	}
+	ids, err := validatorutils.AttrListToIDs(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s notifier IDs: %v", severity, err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,116 @@
+package notificationsmodel
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/labelmatchermodel"
+)
+
+func TestNotificationsPolicyID(t *testing.T) {
+	policyID := uuid.New()
+	notifications := []clientmodels.LabelMatcherNotifications{
+		{
+			Matchers: []clientmodels.LabelMatcher{
+				{Type: amlabels.MatchEqual, Name: "team", Value: "payments"},
+			},
+			NotificationPolicyID: clientmodels.ID{UUID: policyID},
+		},
+	}
+
+	var diags diag.Diagnostics
+	models := FromClientModels(notifications, &diags)
+	assert.False(t, diags.HasError())
+	assert.DeepEqual(t, models, []Model{
+		{
+			Matchers: []labelmatchermodel.Model{
+				{
+					Name:  types.StringValue("team"),
+					Type:  types.StringValue("="),
+					Value: types.StringValue("payments"),
+				},
+			},
+			NotificationPolicyID: types.StringValue(policyID.String()),
+		},
+	})
+
+	roundTrip, err := ToClientModels(models)
+	assert.Nil(t, err)
+	assert.DeepEqual(t, roundTrip, notifications)
+}
+
+func TestNotificationsNotifiers(t *testing.T) {
+	warnID := uuid.New()
+	criticalID := uuid.New()
+	notifications := []clientmodels.LabelMatcherNotifications{
+		{
+			Matchers: []clientmodels.LabelMatcher{
+				{Type: amlabels.MatchRegexp, Name: "env", Value: "prod|staging"},
+			},
+			Notifiers: clientmodels.NotifiersByCondition{
+				Warn:     []clientmodels.ID{{UUID: warnID}},
+				Critical: []clientmodels.ID{{UUID: criticalID}},
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	models := FromClientModels(notifications, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, len(models), 1)
+	assert.True(t, models[0].NotificationPolicyID.IsNull())
+	assert.True(t, models[0].Notifiers != nil)
+	assert.True(t, models[0].Notifiers.Any.IsNull())
+	assert.True(t, models[0].Notifiers.NoData.IsNull())
+	assert.Equal(t, len(models[0].Notifiers.Warn.Elements()), 1)
+	assert.Equal(t, len(models[0].Notifiers.Critical.Elements()), 1)
+
+	roundTrip, err := ToClientModels(models)
+	assert.Nil(t, err)
+	assert.DeepEqual(t, roundTrip, notifications)
+}
+
+func TestNotificationsEmptyMatchers(t *testing.T) {
+	policyID := uuid.New()
+	notifications := []clientmodels.LabelMatcherNotifications{
+		{
+			Matchers:             []clientmodels.LabelMatcher{},
+			NotificationPolicyID: clientmodels.ID{UUID: policyID},
+		},
+	}
+
+	var diags diag.Diagnostics
+	models := FromClientModels(notifications, &diags)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, models[0].Matchers)
+	assert.True(t, models[0].Notifiers == nil)
+
+	// Empty matchers are sent as an empty list, which matches all alerts.
+	roundTrip, err := ToClientModels(models)
+	assert.Nil(t, err)
+	assert.DeepEqual(t, roundTrip, notifications)
+	assert.True(t, roundTrip[0].Matchers != nil)
+}
+
+func TestNotificationsEmpty(t *testing.T) {
+	var diags diag.Diagnostics
+	assert.Nil(t, FromClientModels(nil, &diags))
+	assert.Nil(t, FromClientModels([]clientmodels.LabelMatcherNotifications{}, &diags))
+
+	notifications, err := ToClientModels(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, notifications)
+}
+
+func TestNotificationsInvalidPolicyID(t *testing.T) {
+	_, err := ToClientModels([]Model{
+		{NotificationPolicyID: types.StringValue("not-a-uuid")},
+	})
+	assert.True(t, err != nil)
+}
@@ -15,6 +15,8 @@ import (
 )
 
 type notifierResourceModel struct {
+	resourceutils.InstanceModel
+
 	ID               types.String           `tfsdk:"id"`
 	Name             types.String           `tfsdk:"name"`
 	Type             types.String           `tfsdk:"type"`
@@ -2,16 +2,20 @@ package syntheticmonitor
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource/notificationsmodel"
 	"terraform-provider-oodle/internal/resourceutils"
 	"terraform-provider-oodle/internal/validatorutils"
 )
 
 type syntheticMonitorResourceModel struct {
+	resourceutils.InstanceModel
+
 	ID         types.String                 `tfsdk:"id"`
 	Name       types.String                 `tfsdk:"name"`
 	Enabled    types.Bool                   `tfsdk:"enabled"`
@@ -19,11 +23,17 @@ type syntheticMonitorResourceModel struct {
 	RuleConfig *ruleConfigModel             `tfsdk:"rule_config"`
 	Interval   validatorutils.DurationValue `tfsdk:"interval"`
 	Timeout    validatorutils.DurationValue `tfsdk:"timeout"`
+	Locations  types.List                   `tfsdk:"locations"`
+	Alerting   *alertingModel               `tfsdk:"alerting"`
 }
 
 type ruleConfigModel struct {
 	HTTP      *httpConfigModel      `tfsdk:"http"`
 	Multistep *multistepConfigModel `tfsdk:"multistep"`
+	TCP       *tcpConfigModel       `tfsdk:"tcp"`
+	DNS       *dnsConfigModel       `tfsdk:"dns"`
+	SSL       *sslConfigModel       `tfsdk:"ssl"`
+	GRPC      *grpcConfigModel      `tfsdk:"grpc"`
 }
 
 // httpConfigModel is shared by the single-step "http" rule config and by each
@@ -69,6 +79,51 @@ type extractRuleModel struct {
 	Secret types.Bool   `tfsdk:"secret"`
 }
 
+type tcpConfigModel struct {
+	Host           types.String `tfsdk:"host"`
+	Port           types.Int64  `tfsdk:"port"`
+	ExpectedBanner types.String `tfsdk:"expected_banner"`
+}
+
+type dnsConfigModel struct {
+	Hostname        types.String   `tfsdk:"hostname"`
+	RecordType      types.String   `tfsdk:"record_type"`
+	Resolver        types.String   `tfsdk:"resolver"`
+	ExpectedAnswers []types.String `tfsdk:"expected_answers"`
+}
+
+type sslConfigModel struct {
+	Host               types.String   `tfsdk:"host"`
+	Port               types.Int64    `tfsdk:"port"`
+	MinDaysUntilExpiry types.Int64    `tfsdk:"min_days_until_expiry"`
+	ExpectedIssuer     types.String   `tfsdk:"expected_issuer"`
+	ExpectedSANs       []types.String `tfsdk:"expected_sans"`
+}
+
+type grpcConfigModel struct {
+	Target             types.String      `tfsdk:"target"`
+	Service            types.String      `tfsdk:"service"`
+	TLS                types.Bool        `tfsdk:"tls"`
+	InsecureSkipVerify types.Bool        `tfsdk:"insecure_skip_verify"`
+	Metadata           map[string]string `tfsdk:"metadata"`
+	ExpectedStatus     types.String      `tfsdk:"expected_status"`
+	Call               *grpcCallModel    `tfsdk:"call"`
+}
+
+type grpcCallModel struct {
+	Method           types.String `tfsdk:"method"`
+	Body             types.String `tfsdk:"body"`
+	ExpectedStatus   types.String `tfsdk:"expected_status"`
+	ExpectedResponse types.String `tfsdk:"expected_response"`
+}
+
+type alertingModel struct {
+	FailedLocations     types.Int64                `tfsdk:"failed_locations"`
+	ConsecutiveFailures types.Int64                `tfsdk:"consecutive_failures"`
+	Severity            types.String               `tfsdk:"severity"`
+	Notifications       []notificationsmodel.Model `tfsdk:"notifications"`
+}
+
 var _ resourceutils.ResourceModel[*clientmodels.SyntheticMonitor] = (*syntheticMonitorResourceModel)(nil)
 
 func (m *syntheticMonitorResourceModel) GetID() types.String {
@@ -82,7 +137,7 @@ func (m *syntheticMonitorResourceModel) SetID(id types.String) {
 func (m *syntheticMonitorResourceModel) FromClientModel(
 	_ context.Context,
 	model *clientmodels.SyntheticMonitor,
-	_ *diag.Diagnostics,
+	diags *diag.Diagnostics,
 ) {
 	// Reset the model to clear any existing data.
 	*m = syntheticMonitorResourceModel{}
@@ -93,6 +148,10 @@ func (m *syntheticMonitorResourceModel) FromClientModel(
 	m.RuleType = types.StringValue(model.RuleType)
 	m.Interval = validatorutils.NewDurationValue(model.Interval)
 	m.Timeout = validatorutils.NewDurationValue(model.Timeout)
+	m.Locations = validatorutils.ToAttrList(model.Locations, diags)
+	if model.Alerting != nil {
+		m.Alerting = alertingFromClientModel(model.Alerting, diags)
+	}
 
 	m.RuleConfig = &ruleConfigModel{}
 	if model.RuleConfig.HTTP != nil {
@@ -123,10 +182,22 @@ func (m *syntheticMonitorResourceModel) FromClientModel(
 		}
 		m.RuleConfig.Multistep = &multistepConfigModel{Steps: steps}
 	}
+	if model.RuleConfig.TCP != nil {
+		m.RuleConfig.TCP = tcpConfigFromClientModel(model.RuleConfig.TCP)
+	}
+	if model.RuleConfig.DNS != nil {
+		m.RuleConfig.DNS = dnsConfigFromClientModel(model.RuleConfig.DNS)
+	}
+	if model.RuleConfig.SSL != nil {
+		m.RuleConfig.SSL = sslConfigFromClientModel(model.RuleConfig.SSL)
+	}
+	if model.RuleConfig.GRPC != nil {
+		m.RuleConfig.GRPC = grpcConfigFromClientModel(model.RuleConfig.GRPC)
+	}
 }
 
 func (m *syntheticMonitorResourceModel) ToClientModel(
-	_ context.Context,
+	ctx context.Context,
 	model *clientmodels.SyntheticMonitor,
 ) error {
 	if !m.ID.IsNull() && !m.ID.IsUnknown() {
@@ -139,6 +210,21 @@ func (m *syntheticMonitorResourceModel) ToClientModel(
 	model.Interval = m.Interval.ValueString()
 	model.Timeout = m.Timeout.ValueString()
 
+	// Unknown locations are left to the server to pick.
+	if !m.Locations.IsNull() && !m.Locations.IsUnknown() && len(m.Locations.Elements()) > 0 {
+		if diags := m.Locations.ElementsAs(ctx, &model.Locations, false); diags.HasError() {
+			return fmt.Errorf("failed to read locations: %v", diags)
+		}
+	}
+
+	if m.Alerting != nil {
+		alerting, err := alertingToClientModel(m.Alerting)
+		if err != nil {
+			return err
+		}
+		model.Alerting = alerting
+	}
+
 	if m.RuleConfig == nil {
 		return nil
 	}
@@ -173,10 +259,56 @@ func (m *syntheticMonitorResourceModel) ToClientModel(
 		}
 		model.RuleConfig.Multistep = &clientmodels.SyntheticMonitorMultistepConfig{Steps: steps}
 	}
+	if m.RuleConfig.TCP != nil {
+		model.RuleConfig.TCP = tcpConfigToClientModel(m.RuleConfig.TCP)
+	}
+	if m.RuleConfig.DNS != nil {
+		model.RuleConfig.DNS = dnsConfigToClientModel(m.RuleConfig.DNS)
+	}
+	if m.RuleConfig.SSL != nil {
+		model.RuleConfig.SSL = sslConfigToClientModel(m.RuleConfig.SSL)
+	}
+	if m.RuleConfig.GRPC != nil {
+		model.RuleConfig.GRPC = grpcConfigToClientModel(m.RuleConfig.GRPC)
+	}
 
 	return nil
 }
 
+// alertingFromClientModel converts a client alerting config into the TF model.
+// Thresholds and severity omitted by the server read as their defaults.
+func alertingFromClientModel(c *clientmodels.SyntheticMonitorAlerting, diags *diag.Diagnostics) *alertingModel {
+	cfg := &alertingModel{
+		FailedLocations:     types.Int64Value(defaultFailedLocations),
+		ConsecutiveFailures: types.Int64Value(defaultConsecutiveFailures),
+		Severity:            types.StringValue(defaultAlertSeverity),
+		Notifications:       notificationsmodel.FromClientModels(c.Notifications, diags),
+	}
+	if c.FailedLocations != 0 {
+		cfg.FailedLocations = types.Int64Value(c.FailedLocations)
+	}
+	if c.ConsecutiveFailures != 0 {
+		cfg.ConsecutiveFailures = types.Int64Value(c.ConsecutiveFailures)
+	}
+	if c.Severity != "" {
+		cfg.Severity = types.StringValue(c.Severity)
+	}
+	return cfg
+}
+
+func alertingToClientModel(m *alertingModel) (*clientmodels.SyntheticMonitorAlerting, error) {
+	notifications, err := notificationsmodel.ToClientModels(m.Notifications)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert alerting notifications: %v", err)
+	}
+	return &clientmodels.SyntheticMonitorAlerting{
+		FailedLocations:     m.FailedLocations.ValueInt64(),
+		ConsecutiveFailures: m.ConsecutiveFailures.ValueInt64(),
+		Severity:            m.Severity.ValueString(),
+		Notifications:       notifications,
+	}, nil
+}
+
 // httpConfigFromClientModel converts a client HTTP config into the TF model,
 // leaving optional/empty fields null so they round-trip cleanly.
 func httpConfigFromClientModel(c *clientmodels.SyntheticMonitorHTTPConfig) *httpConfigModel {
@@ -264,6 +396,158 @@ func httpConfigToClientModel(m *httpConfigModel) *clientmodels.SyntheticMonitorH
 	return cfg
 }
 
+func tcpConfigFromClientModel(c *clientmodels.SyntheticMonitorTCPConfig) *tcpConfigModel {
+	cfg := &tcpConfigModel{
+		Host: types.StringValue(c.Host),
+		Port: types.Int64Value(c.Port),
+	}
+	if c.ExpectedBanner != "" {
+		cfg.ExpectedBanner = types.StringValue(c.ExpectedBanner)
+	}
+	return cfg
+}
+
+func tcpConfigToClientModel(m *tcpConfigModel) *clientmodels.SyntheticMonitorTCPConfig {
+	cfg := &clientmodels.SyntheticMonitorTCPConfig{
+		Host: m.Host.ValueString(),
+		Port: m.Port.ValueInt64(),
+	}
+	if !m.ExpectedBanner.IsNull() && !m.ExpectedBanner.IsUnknown() {
+		cfg.ExpectedBanner = m.ExpectedBanner.ValueString()
+	}
+	return cfg
+}
+
+func dnsConfigFromClientModel(c *clientmodels.SyntheticMonitorDNSConfig) *dnsConfigModel {
+	cfg := &dnsConfigModel{
+		Hostname:   types.StringValue(c.Hostname),
+		RecordType: types.StringValue(c.RecordType),
+	}
+	if c.Resolver != "" {
+		cfg.Resolver = types.StringValue(c.Resolver)
+	}
+	if len(c.ExpectedAnswers) > 0 {
+		cfg.ExpectedAnswers = stringsToTFList(c.ExpectedAnswers)
+	}
+	return cfg
+}
+
+func dnsConfigToClientModel(m *dnsConfigModel) *clientmodels.SyntheticMonitorDNSConfig {
+	cfg := &clientmodels.SyntheticMonitorDNSConfig{
+		Hostname:   m.Hostname.ValueString(),
+		RecordType: m.RecordType.ValueString(),
+	}
+	if !m.Resolver.IsNull() && !m.Resolver.IsUnknown() {
+		cfg.Resolver = m.Resolver.ValueString()
+	}
+	if len(m.ExpectedAnswers) > 0 {
+		cfg.ExpectedAnswers = tfListToStrings(m.ExpectedAnswers)
+	}
+	return cfg
+}
+
+func sslConfigFromClientModel(c *clientmodels.SyntheticMonitorSSLConfig) *sslConfigModel {
+	cfg := &sslConfigModel{
+		Host: types.StringValue(c.Host),
+	}
+	if c.Port != 0 {
+		cfg.Port = types.Int64Value(c.Port)
+	}
+	if c.MinDaysUntilExpiry != 0 {
+		cfg.MinDaysUntilExpiry = types.Int64Value(c.MinDaysUntilExpiry)
+	}
+	if c.ExpectedIssuer != "" {
+		cfg.ExpectedIssuer = types.StringValue(c.ExpectedIssuer)
+	}
+	if len(c.ExpectedSANs) > 0 {
+		cfg.ExpectedSANs = stringsToTFList(c.ExpectedSANs)
+	}
+	return cfg
+}
+
+func sslConfigToClientModel(m *sslConfigModel) *clientmodels.SyntheticMonitorSSLConfig {
+	cfg := &clientmodels.SyntheticMonitorSSLConfig{
+		Host: m.Host.ValueString(),
+	}
+	if !m.Port.IsNull() && !m.Port.IsUnknown() {
+		cfg.Port = m.Port.ValueInt64()
+	}
+	if !m.MinDaysUntilExpiry.IsNull() && !m.MinDaysUntilExpiry.IsUnknown() {
+		cfg.MinDaysUntilExpiry = m.MinDaysUntilExpiry.ValueInt64()
+	}
+	if !m.ExpectedIssuer.IsNull() && !m.ExpectedIssuer.IsUnknown() {
+		cfg.ExpectedIssuer = m.ExpectedIssuer.ValueString()
+	}
+	if len(m.ExpectedSANs) > 0 {
+		cfg.ExpectedSANs = tfListToStrings(m.ExpectedSANs)
+	}
+	return cfg
+}
+
+// grpcConfigFromClientModel converts a client gRPC config into the TF model.
+// Empty expected statuses are read as their defaults, which the server applies.
+func grpcConfigFromClientModel(c *clientmodels.SyntheticMonitorGRPCConfig) *grpcConfigModel {
+	cfg := &grpcConfigModel{
+		Target:             types.StringValue(c.Target),
+		TLS:                types.BoolValue(c.TLS),
+		InsecureSkipVerify: types.BoolValue(c.InsecureSkipVerify),
+		ExpectedStatus:     types.StringValue(defaultGRPCHealthStatus),
+	}
+	if c.Service != "" {
+		cfg.Service = types.StringValue(c.Service)
+	}
+	if len(c.Metadata) > 0 {
+		cfg.Metadata = c.Metadata
+	}
+	if c.ExpectedStatus != "" {
+		cfg.ExpectedStatus = types.StringValue(c.ExpectedStatus)
+	}
+	if c.Call != nil {
+		cfg.Call = &grpcCallModel{
+			Method:         types.StringValue(c.Call.Method),
+			ExpectedStatus: types.StringValue(defaultGRPCCallStatus),
+		}
+		if c.Call.Body != "" {
+			cfg.Call.Body = types.StringValue(c.Call.Body)
+		}
+		if c.Call.ExpectedStatus != "" {
+			cfg.Call.ExpectedStatus = types.StringValue(c.Call.ExpectedStatus)
+		}
+		if c.Call.ExpectedResponse != "" {
+			cfg.Call.ExpectedResponse = types.StringValue(c.Call.ExpectedResponse)
+		}
+	}
+	return cfg
+}
+
+func grpcConfigToClientModel(m *grpcConfigModel) *clientmodels.SyntheticMonitorGRPCConfig {
+	cfg := &clientmodels.SyntheticMonitorGRPCConfig{
+		Target:             m.Target.ValueString(),
+		TLS:                m.TLS.ValueBool(),
+		InsecureSkipVerify: m.InsecureSkipVerify.ValueBool(),
+		ExpectedStatus:     m.ExpectedStatus.ValueString(),
+	}
+	if !m.Service.IsNull() && !m.Service.IsUnknown() {
+		cfg.Service = m.Service.ValueString()
+	}
+	if len(m.Metadata) > 0 {
+		cfg.Metadata = m.Metadata
+	}
+	if m.Call != nil {
+		cfg.Call = &clientmodels.SyntheticMonitorGRPCCall{
+			Method:         m.Call.Method.ValueString(),
+			ExpectedStatus: m.Call.ExpectedStatus.ValueString(),
+		}
+		if !m.Call.Body.IsNull() && !m.Call.Body.IsUnknown() {
+			cfg.Call.Body = m.Call.Body.ValueString()
+		}
+		if !m.Call.ExpectedResponse.IsNull() && !m.Call.ExpectedResponse.IsUnknown() {
+			cfg.Call.ExpectedResponse = m.Call.ExpectedResponse.ValueString()
+		}
+	}
+	return cfg
+}
+
 func stringsToTFList(in []string) []types.String {
 	out := make([]types.String, len(in))
 	for i, s := range in {
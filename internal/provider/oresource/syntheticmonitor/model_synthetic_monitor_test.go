@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/prometheus/alertmanager/pkg/labels"
 	"github.com/rubrikinc/testwell/assert"
 
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
@@ -30,8 +32,9 @@ func TestSyntheticMonitorModel(t *testing.T) {
 				InsecureSkipVerify:  false,
 			},
 		},
-		Interval: "30s",
-		Timeout:  "5s",
+		Interval:  "30s",
+		Timeout:   "5s",
+		Locations: []string{"aws:us-east-1", "aws:eu-west-1", "pl-internal"},
 	}
 
 	resourceModel := &syntheticMonitorResourceModel{}
@@ -218,3 +221,258 @@ func TestSyntheticMonitorModelDisabled(t *testing.T) {
 
 	assert.DeepEqual(t, clientModel, newClientModel)
 }
+
+func TestSyntheticMonitorModelNetworkRuleTypes(t *testing.T) {
+	tests := []struct {
+		name       string
+		ruleType   string
+		ruleConfig clientmodels.SyntheticMonitorRuleConfig
+	}{
+		{
+			name:     "tcp",
+			ruleType: "tcp",
+			ruleConfig: clientmodels.SyntheticMonitorRuleConfig{
+				TCP: &clientmodels.SyntheticMonitorTCPConfig{
+					Host:           "git.example.com",
+					Port:           22,
+					ExpectedBanner: "SSH-2.0",
+				},
+			},
+		},
+		{
+			name:     "tcp minimal",
+			ruleType: "tcp",
+			ruleConfig: clientmodels.SyntheticMonitorRuleConfig{
+				TCP: &clientmodels.SyntheticMonitorTCPConfig{
+					Host: "db.example.com",
+					Port: 5432,
+				},
+			},
+		},
+		{
+			name:     "dns",
+			ruleType: "dns",
+			ruleConfig: clientmodels.SyntheticMonitorRuleConfig{
+				DNS: &clientmodels.SyntheticMonitorDNSConfig{
+					Hostname:        "example.com",
+					RecordType:      "A",
+					Resolver:        "8.8.8.8:53",
+					ExpectedAnswers: []string{"93.184.216.34"},
+				},
+			},
+		},
+		{
+			name:     "ssl",
+			ruleType: "ssl",
+			ruleConfig: clientmodels.SyntheticMonitorRuleConfig{
+				SSL: &clientmodels.SyntheticMonitorSSLConfig{
+					Host:               "example.com",
+					Port:               8443,
+					MinDaysUntilExpiry: 14,
+					ExpectedIssuer:     "Let's Encrypt",
+					ExpectedSANs:       []string{"example.com", "www.example.com"},
+				},
+			},
+		},
+		{
+			name:     "ssl minimal",
+			ruleType: "ssl",
+			ruleConfig: clientmodels.SyntheticMonitorRuleConfig{
+				SSL: &clientmodels.SyntheticMonitorSSLConfig{
+					Host: "example.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			clientModel := &clientmodels.SyntheticMonitor{
+				ID:         "test-id-net",
+				Name:       "Network Monitor",
+				Enabled:    true,
+				RuleType:   tt.ruleType,
+				RuleConfig: tt.ruleConfig,
+				Interval:   "1m",
+				Timeout:    "10s",
+			}
+
+			resourceModel := &syntheticMonitorResourceModel{}
+			diags := &diag.Diagnostics{}
+			resourceModel.FromClientModel(ctx, clientModel, diags)
+			assert.False(t, diags.HasError())
+
+			newClientModel := &clientmodels.SyntheticMonitor{}
+			assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+			assert.DeepEqual(t, clientModel, newClientModel)
+		})
+	}
+}
+
+func TestSyntheticMonitorModelGRPC(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *clientmodels.SyntheticMonitorGRPCConfig
+	}{
+		{
+			name: "health check",
+			config: &clientmodels.SyntheticMonitorGRPCConfig{
+				Target:         "orders.internal:50051",
+				ExpectedStatus: "SERVING",
+			},
+		},
+		{
+			name: "unary call",
+			config: &clientmodels.SyntheticMonitorGRPCConfig{
+				Target:             "orders.example.com:443",
+				Service:            "orders.v1.OrderService",
+				TLS:                true,
+				InsecureSkipVerify: true,
+				Metadata: map[string]string{
+					"authorization": "Bearer token",
+				},
+				ExpectedStatus: "SERVING",
+				Call: &clientmodels.SyntheticMonitorGRPCCall{
+					Method:           "orders.v1.OrderService/GetOrder",
+					Body:             `{"id":"synthetic"}`,
+					ExpectedStatus:   "NOT_FOUND",
+					ExpectedResponse: "order",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			clientModel := &clientmodels.SyntheticMonitor{
+				ID:       "test-id-grpc",
+				Name:     "gRPC Monitor",
+				Enabled:  true,
+				RuleType: "grpc",
+				RuleConfig: clientmodels.SyntheticMonitorRuleConfig{
+					GRPC: tt.config,
+				},
+				Interval: "1m",
+				Timeout:  "10s",
+			}
+
+			resourceModel := &syntheticMonitorResourceModel{}
+			diags := &diag.Diagnostics{}
+			resourceModel.FromClientModel(ctx, clientModel, diags)
+			assert.False(t, diags.HasError())
+
+			newClientModel := &clientmodels.SyntheticMonitor{}
+			assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+			assert.DeepEqual(t, clientModel, newClientModel)
+		})
+	}
+}
+
+func TestSyntheticMonitorModelGRPCDefaults(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.SyntheticMonitor{
+		ID:       "test-id-grpc",
+		Name:     "gRPC Monitor",
+		Enabled:  true,
+		RuleType: "grpc",
+		RuleConfig: clientmodels.SyntheticMonitorRuleConfig{
+			GRPC: &clientmodels.SyntheticMonitorGRPCConfig{
+				Target: "orders.internal:50051",
+				Call: &clientmodels.SyntheticMonitorGRPCCall{
+					Method: "orders.v1.OrderService/ListOrders",
+				},
+			},
+		},
+		Interval: "1m",
+		Timeout:  "10s",
+	}
+
+	resourceModel := &syntheticMonitorResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.RuleConfig.GRPC.ExpectedStatus.ValueString(), "SERVING")
+	assert.Equal(t, resourceModel.RuleConfig.GRPC.Call.ExpectedStatus.ValueString(), "OK")
+	assert.True(t, resourceModel.RuleConfig.GRPC.Call.Body.IsNull())
+}
+
+func TestSyntheticMonitorModelAlerting(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.SyntheticMonitor{
+		ID:       "test-id-alerting",
+		Name:     "Checkout API",
+		Enabled:  true,
+		RuleType: "http",
+		RuleConfig: clientmodels.SyntheticMonitorRuleConfig{
+			HTTP: &clientmodels.SyntheticMonitorHTTPConfig{
+				URL:                 "https://checkout.example.com/health",
+				Method:              "GET",
+				ExpectedStatusCodes: []string{"2XX"},
+			},
+		},
+		Interval:  "1m",
+		Timeout:   "10s",
+		Locations: []string{"aws:us-east-1", "aws:eu-west-1", "aws:ap-south-1"},
+		Alerting: &clientmodels.SyntheticMonitorAlerting{
+			FailedLocations:     2,
+			ConsecutiveFailures: 3,
+			Severity:            "warn",
+			Notifications: []clientmodels.LabelMatcherNotifications{
+				{
+					Matchers: []clientmodels.LabelMatcher{
+						{Type: labels.MatchEqual, Name: "team", Value: "payments"},
+					},
+					Notifiers: clientmodels.NotifiersByCondition{
+						Warn:     []clientmodels.ID{{UUID: uuid.New()}},
+						Critical: []clientmodels.ID{{UUID: uuid.New()}, {UUID: uuid.New()}},
+					},
+				},
+				{
+					Matchers:             []clientmodels.LabelMatcher{},
+					NotificationPolicyID: clientmodels.ID{UUID: uuid.New()},
+				},
+			},
+		},
+	}
+
+	resourceModel := &syntheticMonitorResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Nil(t, resourceModel.Alerting.Notifications[1].Matchers)
+	assert.Nil(t, resourceModel.Alerting.Notifications[1].Notifiers)
+
+	newClientModel := &clientmodels.SyntheticMonitor{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestSyntheticMonitorModelAlertingDefaults(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.SyntheticMonitor{
+		ID:       "test-id-alerting",
+		Name:     "Checkout API",
+		Enabled:  true,
+		RuleType: "tcp",
+		RuleConfig: clientmodels.SyntheticMonitorRuleConfig{
+			TCP: &clientmodels.SyntheticMonitorTCPConfig{Host: "db.example.com", Port: 5432},
+		},
+		Interval: "1m",
+		Timeout:  "10s",
+		Alerting: &clientmodels.SyntheticMonitorAlerting{},
+	}
+
+	resourceModel := &syntheticMonitorResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.Alerting.FailedLocations.ValueInt64(), int64(1))
+	assert.Equal(t, resourceModel.Alerting.ConsecutiveFailures.ValueInt64(), int64(1))
+	assert.Equal(t, resourceModel.Alerting.Severity.ValueString(), "critical")
+	assert.Nil(t, resourceModel.Alerting.Notifications)
+}
@@ -5,12 +5,17 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-oodle/internal/oodlehttp"
 	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
 	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/provider/oresource/notificationsmodel"
+	"terraform-provider-oodle/internal/providerdata"
 	"terraform-provider-oodle/internal/validatorutils"
 )
 
@@ -20,13 +25,75 @@ var (
 	_ resource.ResourceWithConfigure        = &syntheticMonitorResource{}
 	_ resource.ResourceWithImportState      = &syntheticMonitorResource{}
 	_ resource.ResourceWithConfigValidators = &syntheticMonitorResource{}
+	_ resource.ResourceWithModifyPlan       = &syntheticMonitorResource{}
 )
 
-const syntheticMonitorsResourcePath = "synthetic-monitors"
+const (
+	syntheticMonitorsResourcePath  = "synthetic-monitors"
+	syntheticVariablesResourcePath = "synthetic-variables"
+)
 
 var validRuleTypes = map[string]struct{}{
 	"http":      {},
 	"multistep": {},
+	"tcp":       {},
+	"dns":       {},
+	"ssl":       {},
+	"grpc":      {},
+}
+
+var validDNSRecordTypes = map[string]struct{}{
+	"A":     {},
+	"AAAA":  {},
+	"CNAME": {},
+	"MX":    {},
+	"NS":    {},
+	"TXT":   {},
+	"SRV":   {},
+	"PTR":   {},
+	"CAA":   {},
+	"SOA":   {},
+}
+
+const (
+	defaultGRPCHealthStatus = "SERVING"
+	defaultGRPCCallStatus   = "OK"
+)
+
+var validGRPCHealthStatuses = map[string]struct{}{
+	"SERVING":     {},
+	"NOT_SERVING": {},
+}
+
+var validGRPCStatusCodes = map[string]struct{}{
+	"OK":                  {},
+	"CANCELLED":           {},
+	"UNKNOWN":             {},
+	"INVALID_ARGUMENT":    {},
+	"DEADLINE_EXCEEDED":   {},
+	"NOT_FOUND":           {},
+	"ALREADY_EXISTS":      {},
+	"PERMISSION_DENIED":   {},
+	"RESOURCE_EXHAUSTED":  {},
+	"FAILED_PRECONDITION": {},
+	"ABORTED":             {},
+	"OUT_OF_RANGE":        {},
+	"UNIMPLEMENTED":       {},
+	"INTERNAL":            {},
+	"UNAVAILABLE":         {},
+	"DATA_LOSS":           {},
+	"UNAUTHENTICATED":     {},
+}
+
+const (
+	defaultFailedLocations     = 1
+	defaultConsecutiveFailures = 1
+	defaultAlertSeverity       = "critical"
+)
+
+var validAlertSeverities = map[string]struct{}{
+	"warn":     {},
+	"critical": {},
 }
 
 var validHTTPMethods = map[string]struct{}{
@@ -69,7 +136,7 @@ func httpConfigAttributes(expectedStatusCodesRequired bool) map[string]schema.At
 	return map[string]schema.Attribute{
 		"url": schema.StringAttribute{
 			Required:    true,
-			Description: "URL to monitor. In multi-step monitors this may reference variables extracted from earlier steps using '{{VAR_NAME}}' syntax.",
+			Description: "URL to monitor. May reference global variables defined with oodle_synthetic_variable and, in multi-step monitors, variables extracted from earlier steps, using '{{VAR_NAME}}' syntax. Placeholders of global variables that do not exist yet produce a plan warning; use the variable's reference attribute to make the monitor depend on it.",
 		},
 		"method": schema.StringAttribute{
 			Required:    true,
@@ -142,6 +209,10 @@ func httpConfigAttributes(expectedStatusCodesRequired bool) map[string]schema.At
 // syntheticMonitorResource is the resource implementation.
 type syntheticMonitorResource struct {
 	oresource.BaseResource[*clientmodels.SyntheticMonitor, *syntheticMonitorResourceModel]
+
+	// variablesClient lists the global variables, to check the variables
+	// referenced by monitors in ModifyPlan.
+	variablesClient *oodlehttp.ModelClient[*clientmodels.SyntheticVariable]
 }
 
 func NewSyntheticMonitorResource() resource.Resource {
@@ -165,6 +236,20 @@ func NewSyntheticMonitorResource() resource.Resource {
 	}
 }
 
+// Configure adds the provider configured client to the resource.
+func (r *syntheticMonitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.BaseResource.Configure(ctx, req, resp)
+	if data, ok := req.ProviderData.(*providerdata.Data); ok {
+		r.variablesClient = oodlehttp.NewModelClient[*clientmodels.SyntheticVariable](
+			data.Client,
+			syntheticVariablesResourcePath,
+			func() *clientmodels.SyntheticVariable {
+				return &clientmodels.SyntheticVariable{}
+			},
+		)
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *syntheticMonitorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_synthetic_monitor"
@@ -174,6 +259,8 @@ func (r *syntheticMonitorResource) Metadata(_ context.Context, req resource.Meta
 func (r *syntheticMonitorResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		validatorutils.NewSyntheticMonitorConfigValidator(),
+		validatorutils.NewSyntheticMultistepValidator(),
+		validatorutils.NewSyntheticAlertingValidator(),
 	}
 }
 
@@ -182,6 +269,7 @@ func (r *syntheticMonitorResource) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		Description: "Manages a synthetic monitor. Synthetic monitors periodically check the availability and performance of endpoints.",
 		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "ID of the synthetic monitor.",
@@ -196,14 +284,14 @@ func (r *syntheticMonitorResource) Schema(_ context.Context, _ resource.SchemaRe
 			},
 			"rule_type": schema.StringAttribute{
 				Required:    true,
-				Description: "Type of the synthetic monitor rule. Possible values: 'http', 'multistep'.",
+				Description: "Type of the synthetic monitor rule. Possible values: 'http', 'multistep', 'tcp', 'dns', 'ssl', 'grpc'.",
 				Validators: []validator.String{
 					validatorutils.NewChoiceValidator(validRuleTypes),
 				},
 			},
 			"rule_config": schema.SingleNestedAttribute{
 				Required:    true,
-				Description: "Configuration for the synthetic monitor rule. Set 'http' for a single-step monitor (rule_type 'http') or 'multistep' for a multi-step monitor (rule_type 'multistep').",
+				Description: "Configuration for the synthetic monitor rule. Exactly one block must be set, and it must match rule_type: 'http' for a single-step HTTP monitor, 'multistep' for a multi-step monitor, 'tcp' for a TCP connection check, 'dns' for a DNS resolution check, 'ssl' for a TLS certificate check or 'grpc' for a gRPC health check.",
 				Attributes: map[string]schema.Attribute{
 					"http": schema.SingleNestedAttribute{
 						Optional:    true,
@@ -278,6 +366,154 @@ func (r *syntheticMonitorResource) Schema(_ context.Context, _ resource.SchemaRe
 							},
 						},
 					},
+					"tcp": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "TCP rule configuration. Used when rule_type is 'tcp'. Checks that a TCP connection can be opened to the host.",
+						Attributes: map[string]schema.Attribute{
+							"host": schema.StringAttribute{
+								Required:    true,
+								Description: "Hostname or IP address to connect to.",
+							},
+							"port": schema.Int64Attribute{
+								Required:    true,
+								Description: "TCP port to connect to (1-65535).",
+								Validators: []validator.Int64{
+									validatorutils.NewInt64RangeValidator(1, 65535),
+								},
+							},
+							"expected_banner": schema.StringAttribute{
+								Optional:    true,
+								Description: "Substring that must appear in the first data the server sends after the connection is opened (e.g., 'SSH-2.0').",
+							},
+						},
+					},
+					"dns": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "DNS rule configuration. Used when rule_type is 'dns'. Checks that a hostname resolves, optionally to the expected answers.",
+						Attributes: map[string]schema.Attribute{
+							"hostname": schema.StringAttribute{
+								Required:    true,
+								Description: "Hostname to resolve.",
+							},
+							"record_type": schema.StringAttribute{
+								Required:    true,
+								Description: "DNS record type to query. Possible values: 'A', 'AAAA', 'CNAME', 'MX', 'NS', 'TXT', 'SRV', 'PTR', 'CAA', 'SOA'.",
+								Validators: []validator.String{
+									validatorutils.NewChoiceValidator(validDNSRecordTypes),
+								},
+							},
+							"resolver": schema.StringAttribute{
+								Optional:    true,
+								Description: "DNS server to query, as 'host' or 'host:port' (e.g., '8.8.8.8'). Defaults to the system resolver.",
+							},
+							"expected_answers": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "Values that must all appear in the answer section (e.g., IP addresses for 'A' records).",
+							},
+						},
+					},
+					"ssl": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "TLS certificate rule configuration. Used when rule_type is 'ssl'. Checks the certificate served by the host.",
+						Attributes: map[string]schema.Attribute{
+							"host": schema.StringAttribute{
+								Required:    true,
+								Description: "Hostname to connect to. It is also sent as the TLS server name.",
+							},
+							"port": schema.Int64Attribute{
+								Optional:    true,
+								Description: "TLS port to connect to (1-65535). Defaults to 443.",
+								Validators: []validator.Int64{
+									validatorutils.NewInt64RangeValidator(1, 65535),
+								},
+							},
+							"min_days_until_expiry": schema.Int64Attribute{
+								Optional:    true,
+								Description: "Fail the check if the certificate expires in fewer than this many days.",
+								Validators: []validator.Int64{
+									validatorutils.NewInt64AtLeastValidator(0),
+								},
+							},
+							"expected_issuer": schema.StringAttribute{
+								Optional:    true,
+								Description: "Substring that must appear in the certificate issuer's distinguished name (e.g., \"Let's Encrypt\").",
+							},
+							"expected_sans": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "Names that must all appear in the certificate's subject alternative names.",
+							},
+						},
+					},
+					"grpc": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "gRPC rule configuration. Used when rule_type is 'grpc'. Checks the server through the grpc.health.v1 health checking protocol, optionally followed by a unary call.",
+						Attributes: map[string]schema.Attribute{
+							"target": schema.StringAttribute{
+								Required:    true,
+								Description: "Server address, as 'host:port'.",
+							},
+							"service": schema.StringAttribute{
+								Optional:    true,
+								Description: "Service name sent in the health check request. Leave unset to check the health of the server as a whole.",
+							},
+							"tls": schema.BoolAttribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Whether to connect over TLS. Defaults to false.",
+							},
+							"insecure_skip_verify": schema.BoolAttribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Whether to skip TLS certificate verification. Defaults to false.",
+							},
+							"metadata": schema.MapAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "gRPC metadata headers to send with every request.",
+							},
+							"expected_status": schema.StringAttribute{
+								Optional:    true,
+								Computed:    true,
+								Default:     validatorutils.NewDefaultString(types.StringValue(defaultGRPCHealthStatus)),
+								Description: "Serving status the health check must report. Possible values: 'SERVING', 'NOT_SERVING'. Defaults to 'SERVING'.",
+								Validators: []validator.String{
+									validatorutils.NewChoiceValidator(validGRPCHealthStatuses),
+								},
+							},
+							"call": schema.SingleNestedAttribute{
+								Optional:    true,
+								Description: "Unary call made after the health check passes. The method is resolved through server reflection.",
+								Attributes: map[string]schema.Attribute{
+									"method": schema.StringAttribute{
+										Required:    true,
+										Description: "Fully qualified method to call, as 'package.Service/Method'.",
+									},
+									"body": schema.StringAttribute{
+										Optional:    true,
+										Description: "Request message, encoded as a JSON object (e.g., with jsonencode()).",
+										Validators: []validator.String{
+											validatorutils.NewJSONObjectValidator(),
+										},
+									},
+									"expected_status": schema.StringAttribute{
+										Optional:    true,
+										Computed:    true,
+										Default:     validatorutils.NewDefaultString(types.StringValue(defaultGRPCCallStatus)),
+										Description: "gRPC status code the call must return (e.g., 'OK', 'NOT_FOUND'). Defaults to 'OK'.",
+										Validators: []validator.String{
+											validatorutils.NewChoiceValidator(validGRPCStatusCodes),
+										},
+									},
+									"expected_response": schema.StringAttribute{
+										Optional:    true,
+										Description: "Substring that must appear in the JSON encoding of the response message.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"interval": schema.StringAttribute{
@@ -296,6 +532,58 @@ func (r *syntheticMonitorResource) Schema(_ context.Context, _ resource.SchemaRe
 					validatorutils.NewDurationValidator(),
 				},
 			},
+			"locations": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the locations to run the checks from: managed locations, as listed by the " +
+					"oodle_synthetic_locations data source, or IDs of oodle_synthetic_private_location resources. " +
+					"Defaults to the locations picked by Oodle.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alerting": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Alert raised when checks fail. The alert is created and deleted together with the " +
+					"synthetic monitor, so no separate oodle_monitor is needed.",
+				Attributes: map[string]schema.Attribute{
+					"failed_locations": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(defaultFailedLocations),
+						Description: "Number of locations that must fail for the alert to fire, out of all the locations of the monitor. Defaults to 1.",
+						Validators: []validator.Int64{
+							validatorutils.NewInt64AtLeastValidator(1),
+						},
+					},
+					"consecutive_failures": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(defaultConsecutiveFailures),
+						Description: "Number of consecutive failed checks after which a location counts as failed. Defaults to 1.",
+						Validators: []validator.Int64{
+							validatorutils.NewInt64AtLeastValidator(1),
+						},
+					},
+					"severity": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     validatorutils.NewDefaultString(types.StringValue(defaultAlertSeverity)),
+						Description: "Severity of the alert. Possible values: 'warn', 'critical'. Defaults to 'critical'.",
+						Validators: []validator.String{
+							validatorutils.NewChoiceValidator(validAlertSeverities),
+						},
+					},
+					"notifications": schema.ListNestedAttribute{
+						Optional:    true,
+						Description: "List of label matcher notifications, as for oodle_monitor. These notifications are evaluated in order, and the first matching notification is used.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: notificationsmodel.Schema(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,174 @@
+package syntheticmonitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/syntheticvars"
+)
+
+// variableReference is a {{NAME}} placeholder in the rule configuration.
+type variableReference struct {
+	path path.Path
+	name string
+}
+
+// ModifyPlan warns about global variables referenced by the rule
+// configuration that do not exist. Placeholders built from a variable's
+// reference are only known once the variable exists, so they are not checked.
+// A literal placeholder may still name a variable created by the same apply,
+// which is why an undefined variable is a warning rather than an error.
+func (r *syntheticMonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.BaseResource.ModifyPlan(ctx, req, resp)
+
+	// Nothing to check when the resource is destroyed.
+	if req.Plan.Raw.IsNull() || r.variablesClient == nil {
+		return
+	}
+
+	var instance types.String
+	var ruleConfig types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance"), &instance)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rule_config"), &ruleConfig)...)
+	if resp.Diagnostics.HasError() || instance.IsUnknown() {
+		return
+	}
+
+	references, ok := globalReferences(ruleConfig)
+	if !ok || len(references) == 0 {
+		return
+	}
+
+	variables, err := r.variablesClient.ForInstance(instance.ValueString()).List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing synthetic variables",
+			fmt.Sprintf("Could not list synthetic variables to check the variables referenced by the monitor: %v", err),
+		)
+		return
+	}
+	defined := map[string]struct{}{}
+	for _, variable := range variables {
+		defined[variable.Name] = struct{}{}
+	}
+
+	for _, ref := range references {
+		if _, ok := defined[ref.name]; ok {
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			ref.path,
+			"Undefined variable",
+			fmt.Sprintf("{{%s}} does not reference a variable extracted by an earlier step or an existing "+
+				"oodle_synthetic_variable. If the variable is created by the same apply, use its reference "+
+				"attribute instead of a literal placeholder so the monitor is created after it.", ref.name),
+		)
+	}
+}
+
+// globalReferences returns the placeholders in the rule configuration that
+// reference global variables, i.e. that no multi-step extract rule defines.
+// It returns false if the names extracted by the steps are not known yet.
+func globalReferences(ruleConfig types.Object) ([]variableReference, bool) {
+	extracted, ok := extractedNames(ruleConfig)
+	if !ok {
+		return nil, false
+	}
+
+	var references []variableReference
+	walkStrings(path.Root("rule_config"), ruleConfig, func(p path.Path, s string) {
+		for _, name := range syntheticvars.References(s) {
+			// Invalid names are reported by the multi-step validator.
+			if _, ok := extracted[name]; ok || !syntheticvars.ValidName(name) {
+				continue
+			}
+			references = append(references, variableReference{path: p, name: name})
+		}
+	})
+	return references, true
+}
+
+// extractedNames returns the names of the variables extracted by the steps of
+// a multi-step rule configuration, and false if some are unknown.
+func extractedNames(ruleConfig types.Object) (map[string]struct{}, bool) {
+	names := map[string]struct{}{}
+	multistep, ok := ruleConfig.Attributes()["multistep"].(types.Object)
+	if !ok || multistep.IsNull() {
+		return names, true
+	}
+	if multistep.IsUnknown() {
+		return nil, false
+	}
+	steps, ok := multistep.Attributes()["steps"].(types.List)
+	if !ok || steps.IsUnknown() {
+		return nil, false
+	}
+
+	for _, step := range steps.Elements() {
+		stepObj, ok := step.(types.Object)
+		if !ok || stepObj.IsUnknown() {
+			return nil, false
+		}
+		extract, ok := stepObj.Attributes()["extract"].(types.List)
+		if !ok || extract.IsNull() {
+			continue
+		}
+		if extract.IsUnknown() {
+			return nil, false
+		}
+		for _, rule := range extract.Elements() {
+			ruleObj, ok := rule.(types.Object)
+			if !ok || ruleObj.IsUnknown() {
+				return nil, false
+			}
+			name, ok := ruleObj.Attributes()["name"].(types.String)
+			if !ok || name.IsUnknown() {
+				return nil, false
+			}
+			names[name.ValueString()] = struct{}{}
+		}
+	}
+	return names, true
+}
+
+// walkStrings calls fn with the path and value of every known string within
+// value, in a stable order.
+func walkStrings(p path.Path, value attr.Value, fn func(path.Path, string)) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		fn(p, v.ValueString())
+	case types.Object:
+		attrs := v.Attributes()
+		for _, name := range sortedKeys(attrs) {
+			walkStrings(p.AtName(name), attrs[name], fn)
+		}
+	case types.Map:
+		elements := v.Elements()
+		for _, key := range sortedKeys(elements) {
+			walkStrings(p.AtMapKey(key), elements[key], fn)
+		}
+	case types.List:
+		for i, element := range v.Elements() {
+			walkStrings(p.AtListIndex(i), element, fn)
+		}
+	}
+}
+
+func sortedKeys(values map[string]attr.Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,116 @@
+package syntheticmonitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// planRuleConfig returns the rule_config of model as planned.
+func planRuleConfig(t *testing.T, model *syntheticMonitorResourceModel) types.Object {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&syntheticMonitorResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	assert.False(t, plan.Set(ctx, model).HasError())
+
+	var ruleConfig types.Object
+	assert.False(t, plan.GetAttribute(ctx, path.Root("rule_config"), &ruleConfig).HasError())
+	return ruleConfig
+}
+
+func newTestModel(ruleType string, ruleConfig *ruleConfigModel) *syntheticMonitorResourceModel {
+	return &syntheticMonitorResourceModel{
+		Name:       types.StringValue("test"),
+		Enabled:    types.BoolValue(true),
+		RuleType:   types.StringValue(ruleType),
+		RuleConfig: ruleConfig,
+		Interval:   validatorutils.NewDurationValue("1m"),
+		Timeout:    validatorutils.NewDurationValue("10s"),
+		Locations:  types.ListNull(types.StringType),
+	}
+}
+
+func TestGlobalReferences(t *testing.T) {
+	model := newTestModel("http", &ruleConfigModel{
+		HTTP: &httpConfigModel{
+			URL:    types.StringValue("https://{{API_HOST}}/health"),
+			Method: types.StringValue("GET"),
+			Headers: map[string]string{
+				"X-Api-Key": "{{API_KEY}}",
+				"X-Trace":   "{{ bad name }}",
+			},
+			ExpectedStatusCodes: []types.String{types.StringValue("2XX")},
+			BasicAuth: &basicAuthModel{
+				Username: types.StringValue("probe"),
+				Password: types.StringValue("{{PROBE_PASSWORD}}"),
+			},
+		},
+	})
+
+	references, ok := globalReferences(planRuleConfig(t, model))
+	assert.True(t, ok)
+	assert.DeepEqual(t, references, []variableReference{
+		{path: path.Root("rule_config").AtName("http").AtName("basic_auth").AtName("password"), name: "PROBE_PASSWORD"},
+		{path: path.Root("rule_config").AtName("http").AtName("headers").AtMapKey("X-Api-Key"), name: "API_KEY"},
+		{path: path.Root("rule_config").AtName("http").AtName("url"), name: "API_HOST"},
+	})
+}
+
+func TestGlobalReferencesMultistep(t *testing.T) {
+	model := newTestModel("multistep", &ruleConfigModel{
+		Multistep: &multistepConfigModel{
+			Steps: []stepModel{
+				{
+					Name: types.StringValue("Login"),
+					Request: &httpConfigModel{
+						URL:  types.StringValue("https://{{API_HOST}}/login"),
+						Body: types.StringValue(`{"password": "{{PROBE_PASSWORD}}"}`),
+					},
+					Extract: []extractRuleModel{
+						{
+							Name:   types.StringValue("ACCESS_TOKEN"),
+							Source: types.StringValue("body"),
+							Parser: types.StringValue("jsonpath"),
+							Query:  types.StringValue("$.token"),
+						},
+					},
+				},
+				{
+					Name: types.StringValue("Profile"),
+					Request: &httpConfigModel{
+						URL:         types.StringValue("https://{{API_HOST}}/me"),
+						BearerToken: types.StringValue("{{ACCESS_TOKEN}}"),
+					},
+				},
+			},
+		},
+	})
+
+	references, ok := globalReferences(planRuleConfig(t, model))
+	assert.True(t, ok)
+	steps := path.Root("rule_config").AtName("multistep").AtName("steps")
+	assert.DeepEqual(t, references, []variableReference{
+		{path: steps.AtListIndex(0).AtName("request").AtName("body"), name: "PROBE_PASSWORD"},
+		{path: steps.AtListIndex(0).AtName("request").AtName("url"), name: "API_HOST"},
+		{path: steps.AtListIndex(1).AtName("request").AtName("url"), name: "API_HOST"},
+	})
+
+	// References cannot be told apart from extracted variables while the
+	// extracted names are unknown.
+	model.RuleConfig.Multistep.Steps[0].Extract[0].Name = types.StringUnknown()
+	_, ok = globalReferences(planRuleConfig(t, model))
+	assert.False(t, ok)
+}
@@ -0,0 +1,68 @@
+package syntheticprivatelocation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/resourceutils"
+)
+
+type syntheticPrivateLocationResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	EnrollmentToken types.String `tfsdk:"enrollment_token"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.SyntheticPrivateLocation] = (*syntheticPrivateLocationResourceModel)(nil)
+
+func (m *syntheticPrivateLocationResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *syntheticPrivateLocationResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *syntheticPrivateLocationResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.SyntheticPrivateLocation,
+	_ *diag.Diagnostics,
+) {
+	// The enrollment token is only returned when the location is created, so
+	// the token from the prior state is kept otherwise.
+	enrollmentToken := m.EnrollmentToken
+
+	// Reset the model to clear any existing data.
+	*m = syntheticPrivateLocationResourceModel{}
+
+	m.ID = types.StringValue(model.ID)
+	m.Name = types.StringValue(model.Name)
+	if model.Description != "" {
+		m.Description = types.StringValue(model.Description)
+	}
+	m.EnrollmentToken = enrollmentToken
+	if model.EnrollmentToken != "" {
+		m.EnrollmentToken = types.StringValue(model.EnrollmentToken)
+	}
+}
+
+func (m *syntheticPrivateLocationResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.SyntheticPrivateLocation,
+) error {
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID = m.ID.ValueString()
+	}
+
+	model.Name = m.Name.ValueString()
+	if !m.Description.IsNull() && !m.Description.IsUnknown() {
+		model.Description = m.Description.ValueString()
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package syntheticprivatelocation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestSyntheticPrivateLocationModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.SyntheticPrivateLocation{
+		ID:          "pl-123",
+		Name:        "Production VPC",
+		Description: "Agent in the prod-us-east-1 VPC",
+	}
+
+	resourceModel := &syntheticPrivateLocationResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, resourceModel.EnrollmentToken.IsNull())
+
+	newClientModel := &clientmodels.SyntheticPrivateLocation{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestSyntheticPrivateLocationModelEnrollmentToken(t *testing.T) {
+	ctx := context.Background()
+	resourceModel := &syntheticPrivateLocationResourceModel{}
+	diags := &diag.Diagnostics{}
+
+	// The token is returned on create.
+	resourceModel.FromClientModel(ctx, &clientmodels.SyntheticPrivateLocation{
+		ID:              "pl-123",
+		Name:            "Production VPC",
+		EnrollmentToken: "enroll-abc",
+	}, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.EnrollmentToken.ValueString(), "enroll-abc")
+
+	// It is kept when later reads do not return it.
+	resourceModel.FromClientModel(ctx, &clientmodels.SyntheticPrivateLocation{
+		ID:   "pl-123",
+		Name: "Production VPC",
+	}, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.EnrollmentToken.ValueString(), "enroll-abc")
+
+	// The token is never sent back to the API.
+	clientModel := &clientmodels.SyntheticPrivateLocation{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, clientModel))
+	assert.Equal(t, clientModel.EnrollmentToken, "")
+}
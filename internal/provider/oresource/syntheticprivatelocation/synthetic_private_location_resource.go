@@ -0,0 +1,101 @@
+package syntheticprivatelocation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &syntheticPrivateLocationResource{}
+	_ resource.ResourceWithConfigure   = &syntheticPrivateLocationResource{}
+	_ resource.ResourceWithImportState = &syntheticPrivateLocationResource{}
+	_ resource.ResourceWithModifyPlan  = &syntheticPrivateLocationResource{}
+)
+
+const syntheticPrivateLocationsResourcePath = "synthetic-private-locations"
+
+// syntheticPrivateLocationResource is the resource implementation.
+type syntheticPrivateLocationResource struct {
+	oresource.BaseResource[*clientmodels.SyntheticPrivateLocation, *syntheticPrivateLocationResourceModel]
+}
+
+func NewSyntheticPrivateLocationResource() resource.Resource {
+	modelCreator := func() *clientmodels.SyntheticPrivateLocation {
+		return &clientmodels.SyntheticPrivateLocation{}
+	}
+	return &syntheticPrivateLocationResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.SyntheticPrivateLocation, *syntheticPrivateLocationResourceModel](
+			func() *syntheticPrivateLocationResourceModel {
+				return &syntheticPrivateLocationResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.SyntheticPrivateLocation] {
+				return oodlehttp.NewModelClient[*clientmodels.SyntheticPrivateLocation](
+					oodleHttpClient,
+					syntheticPrivateLocationsResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *syntheticPrivateLocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synthetic_private_location"
+}
+
+// ModifyPlan keeps the enrollment token of existing locations, which is only
+// returned when the location is created, instead of planning it as unknown.
+func (r *syntheticPrivateLocationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.BaseResource.ModifyPlan(ctx, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var enrollmentToken types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("enrollment_token"), &enrollmentToken)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("enrollment_token"), enrollmentToken)...)
+}
+
+// Schema defines the schema for the resource.
+func (r *syntheticPrivateLocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a synthetic private location. Private locations run synthetic checks from an agent " +
+			"deployed in your own network, so that internal endpoints can be monitored.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the private location. Use it in the locations of oodle_synthetic_monitor resources.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Human-readable name for the private location.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the private location, e.g. the network it is deployed in.",
+			},
+			"enrollment_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				Description: "Token that the agent registers with. It is only returned when the location is " +
+					"created, so it is not set for imported locations.",
+			},
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package syntheticvariable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/resourceutils"
+	"terraform-provider-oodle/internal/syntheticvars"
+)
+
+type syntheticVariableResourceModel struct {
+	resourceutils.InstanceModel
+
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+	Secret    types.Bool   `tfsdk:"secret"`
+	Reference types.String `tfsdk:"reference"`
+}
+
+var _ resourceutils.ResourceModel[*clientmodels.SyntheticVariable] = (*syntheticVariableResourceModel)(nil)
+
+func (m *syntheticVariableResourceModel) GetID() types.String {
+	return m.ID
+}
+
+func (m *syntheticVariableResourceModel) SetID(id types.String) {
+	m.ID = id
+}
+
+func (m *syntheticVariableResourceModel) FromClientModel(
+	_ context.Context,
+	model *clientmodels.SyntheticVariable,
+	_ *diag.Diagnostics,
+) {
+	// The values of secret variables are write-only: the API never returns
+	// them, so the value from the plan or the prior state is kept.
+	value := m.Value
+
+	// Reset the model to clear any existing data.
+	*m = syntheticVariableResourceModel{}
+
+	m.ID = types.StringValue(model.ID)
+	m.Name = types.StringValue(model.Name)
+	m.Secret = types.BoolValue(model.Secret)
+	if model.Secret {
+		m.Value = value
+	} else {
+		m.Value = types.StringValue(model.Value)
+	}
+	m.Reference = types.StringValue(syntheticvars.Reference(model.Name))
+}
+
+func (m *syntheticVariableResourceModel) ToClientModel(
+	_ context.Context,
+	model *clientmodels.SyntheticVariable,
+) error {
+	if !m.ID.IsNull() && !m.ID.IsUnknown() {
+		model.ID = m.ID.ValueString()
+	}
+
+	model.Name = m.Name.ValueString()
+	model.Value = m.Value.ValueString()
+	model.Secret = m.Secret.ValueBool()
+	return nil
+}
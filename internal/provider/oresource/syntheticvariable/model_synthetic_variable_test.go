@@ -0,0 +1,71 @@
+package syntheticvariable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+func TestSyntheticVariableModel(t *testing.T) {
+	ctx := context.Background()
+	clientModel := &clientmodels.SyntheticVariable{
+		ID:    "test-id-123",
+		Name:  "API_HOST",
+		Value: "api.example.com",
+	}
+
+	resourceModel := &syntheticVariableResourceModel{}
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, clientModel, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.Reference.ValueString(), "{{API_HOST}}")
+
+	newClientModel := &clientmodels.SyntheticVariable{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, newClientModel))
+
+	assert.DeepEqual(t, clientModel, newClientModel)
+}
+
+func TestSyntheticVariableModelSecret(t *testing.T) {
+	ctx := context.Background()
+	resourceModel := &syntheticVariableResourceModel{
+		Name:   types.StringValue("API_TOKEN"),
+		Value:  types.StringValue("s3cr3t"),
+		Secret: types.BoolValue(true),
+	}
+
+	clientModel := &clientmodels.SyntheticVariable{}
+	assert.Nil(t, resourceModel.ToClientModel(ctx, clientModel))
+	assert.DeepEqual(t, clientModel, &clientmodels.SyntheticVariable{
+		Name:   "API_TOKEN",
+		Value:  "s3cr3t",
+		Secret: true,
+	})
+
+	// The API does not return the value of secret variables, so the
+	// configured value is kept.
+	diags := &diag.Diagnostics{}
+	resourceModel.FromClientModel(ctx, &clientmodels.SyntheticVariable{
+		ID:     "test-id-456",
+		Name:   "API_TOKEN",
+		Secret: true,
+	}, diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, resourceModel.ID.ValueString(), "test-id-456")
+	assert.Equal(t, resourceModel.Value.ValueString(), "s3cr3t")
+
+	// An imported secret variable has no value until it is configured.
+	imported := &syntheticVariableResourceModel{}
+	imported.FromClientModel(ctx, &clientmodels.SyntheticVariable{
+		ID:     "test-id-456",
+		Name:   "API_TOKEN",
+		Secret: true,
+	}, diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, imported.Value.IsNull())
+}
@@ -0,0 +1,118 @@
+package syntheticvariable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+	"terraform-provider-oodle/internal/provider/oresource"
+	"terraform-provider-oodle/internal/syntheticvars"
+	"terraform-provider-oodle/internal/validatorutils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &syntheticVariableResource{}
+	_ resource.ResourceWithConfigure   = &syntheticVariableResource{}
+	_ resource.ResourceWithImportState = &syntheticVariableResource{}
+	_ resource.ResourceWithModifyPlan  = &syntheticVariableResource{}
+)
+
+const syntheticVariablesResourcePath = "synthetic-variables"
+
+// syntheticVariableResource is the resource implementation.
+type syntheticVariableResource struct {
+	oresource.BaseResource[*clientmodels.SyntheticVariable, *syntheticVariableResourceModel]
+}
+
+func NewSyntheticVariableResource() resource.Resource {
+	modelCreator := func() *clientmodels.SyntheticVariable {
+		return &clientmodels.SyntheticVariable{}
+	}
+	return &syntheticVariableResource{
+		BaseResource: oresource.NewBaseResource[*clientmodels.SyntheticVariable, *syntheticVariableResourceModel](
+			func() *syntheticVariableResourceModel {
+				return &syntheticVariableResourceModel{}
+			},
+			modelCreator,
+			func(oodleHttpClient *oodlehttp.OodleApiClient) *oodlehttp.ModelClient[*clientmodels.SyntheticVariable] {
+				return oodlehttp.NewModelClient[*clientmodels.SyntheticVariable](
+					oodleHttpClient,
+					syntheticVariablesResourcePath,
+					modelCreator,
+				)
+			},
+		),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *syntheticVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synthetic_variable"
+}
+
+// ModifyPlan plans the reference of existing variables from their name. It is
+// left unknown on create, so that monitors using it are only checked against
+// the API once the variable exists.
+func (r *syntheticVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.BaseResource.ModifyPlan(ctx, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("reference"),
+		types.StringValue(syntheticvars.Reference(name.ValueString())))...)
+}
+
+// Schema defines the schema for the resource.
+func (r *syntheticVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a global synthetic variable. Synthetic monitors reference global variables with " +
+			"'{{NAME}}' placeholders, so that values such as credentials are defined once and shared.",
+		Attributes: map[string]schema.Attribute{
+			"instance": oresource.InstanceSchemaAttribute(),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the synthetic variable.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Variable name. Must be uppercase, start with a letter, and be at least 3 characters (e.g., 'API_TOKEN').",
+				Validators: []validator.String{
+					validatorutils.NewRegexValidator(syntheticvars.NamePattern,
+						"must be uppercase, start with a letter, and be at least 3 characters"),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Description: "Value of the variable. The value of a secret variable is write-only: it is never read " +
+					"back from Oodle, so changes made outside of Terraform are not detected.",
+			},
+			"secret": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the value is write-only and redacted in results and logs. Defaults to false.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"reference": schema.StringAttribute{
+				Computed: true,
+				Description: "Placeholder that references the variable in synthetic monitors, i.e. '{{NAME}}'. " +
+					"Using it instead of a literal placeholder makes monitors depend on the variable.",
+			},
+		},
+	}
+}
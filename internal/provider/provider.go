@@ -2,9 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
+	stdpath "path"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -13,39 +19,72 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-oodle/internal/oodlehttp"
+	"terraform-provider-oodle/internal/provider/odatasource/cardinalitylimitusage"
 	dsGrafanaDashboards "terraform-provider-oodle/internal/provider/odatasource/grafanadashboards"
 	dsGrafanaFolders "terraform-provider-oodle/internal/provider/odatasource/grafanafolders"
 	dsLogmetrics "terraform-provider-oodle/internal/provider/odatasource/logmetrics"
+	"terraform-provider-oodle/internal/provider/odatasource/logmetricspreview"
+	"terraform-provider-oodle/internal/provider/odatasource/logmetricsseries"
+	"terraform-provider-oodle/internal/provider/odatasource/metricdropruleimpact"
 	dsMonitors "terraform-provider-oodle/internal/provider/odatasource/monitors"
 	dsNotificationPolicies "terraform-provider-oodle/internal/provider/odatasource/notificationpolicies"
 	dsNotifiers "terraform-provider-oodle/internal/provider/odatasource/notifiers"
+	"terraform-provider-oodle/internal/provider/odatasource/syntheticlocations"
 	"terraform-provider-oodle/internal/provider/oresource/awsintegration"
+	"terraform-provider-oodle/internal/provider/oresource/cardinalitylimit"
 	"terraform-provider-oodle/internal/provider/oresource/grafanadashboard"
 	"terraform-provider-oodle/internal/provider/oresource/grafanafolder"
+	"terraform-provider-oodle/internal/provider/oresource/logarchive"
+	"terraform-provider-oodle/internal/provider/oresource/logdroprule"
 	"terraform-provider-oodle/internal/provider/oresource/logmetrics"
+	"terraform-provider-oodle/internal/provider/oresource/logpipeline"
+	"terraform-provider-oodle/internal/provider/oresource/logretentionpolicy"
+	"terraform-provider-oodle/internal/provider/oresource/logview"
+	"terraform-provider-oodle/internal/provider/oresource/metricaggregationrule"
 	"terraform-provider-oodle/internal/provider/oresource/metricdroprule"
+	"terraform-provider-oodle/internal/provider/oresource/metricrelabelrule"
 	"terraform-provider-oodle/internal/provider/oresource/monitor"
 	notificationPolicy "terraform-provider-oodle/internal/provider/oresource/notificationpolicy"
 	"terraform-provider-oodle/internal/provider/oresource/notifier"
 	"terraform-provider-oodle/internal/provider/oresource/syntheticmonitor"
+	"terraform-provider-oodle/internal/provider/oresource/syntheticprivatelocation"
+	"terraform-provider-oodle/internal/provider/oresource/syntheticvariable"
+	"terraform-provider-oodle/internal/providerdata"
 )
 
 const (
-	deploymentUrlField = "deployment_url"
-	instanceField      = "instance"
-	apiKeyField        = "api_key"
+	deploymentUrlField     = "deployment_url"
+	instanceField          = "instance"
+	apiKeyField            = "api_key"
+	instanceApiKeysField   = "instance_api_keys"
+	profileField           = "profile"
+	credentialProcessField = "credential_process"
+
+	highCardinalityFieldsField = "high_cardinality_fields"
 )
 
+// defaultHighCardinalityFields are the log fields treated as high cardinality
+// when high_cardinality_fields is not set.
+var defaultHighCardinalityFields = []string{"*_id", "trace_id", "msg"}
+
 // oodleProviderModel maps provider schema data to a Go type.
 type oodleProviderModel struct {
-	DeploymentUrl types.String `tfsdk:"deployment_url"`
-	Instance      types.String `tfsdk:"instance"`
-	APIKey        types.String `tfsdk:"api_key"`
+	DeploymentUrl     types.String `tfsdk:"deployment_url"`
+	Instance          types.String `tfsdk:"instance"`
+	APIKey            types.String `tfsdk:"api_key"`
+	InstanceAPIKeys   types.Map    `tfsdk:"instance_api_keys"`
+	Profile           types.String `tfsdk:"profile"`
+	CredentialProcess types.String `tfsdk:"credential_process"`
+	OAuth2            types.Object `tfsdk:"oauth2"`
+	WorkloadIdentity  types.Object `tfsdk:"workload_identity"`
+
+	HighCardinalityFields types.List `tfsdk:"high_cardinality_fields"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &oodleProvider{}
+	_ provider.Provider              = &oodleProvider{}
+	_ provider.ProviderWithFunctions = &oodleProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -85,6 +124,40 @@ func (p *oodleProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:  true,
 				Sensitive: true,
 			},
+			instanceApiKeysField: schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "API keys for instances other than the default one, keyed by instance. " +
+					"Resources and data sources select an instance with their `instance` attribute; " +
+					"instances without an entry use `api_key`.",
+			},
+			profileField: schema.StringAttribute{
+				Optional: true,
+				Description: "Profile to read from the credentials file, `~/.oodle/credentials` unless " +
+					"`OODLE_CREDENTIALS_FILE` is set. Can also be set with the `OODLE_PROFILE` environment " +
+					"variable. Settings of the profile apply when they are not set in the configuration or " +
+					"environment. The `default` profile is used when the file exists and no profile is selected.",
+			},
+			credentialProcessField: schema.StringAttribute{
+				Optional: true,
+				Description: "Command that prints credentials as a JSON object with `deployment_url`, " +
+					"`instance`, `api_key` and `expires_at` (RFC 3339) fields. Only `api_key` is required. " +
+					"When set in the configuration, the command takes precedence over API keys from the " +
+					"environment and the profile, and conflicts with `api_key`. The command from the profile " +
+					"only runs when no API key is found elsewhere. It runs again shortly before the " +
+					"credentials expire. Overrides the `credential_process` of the profile.",
+			},
+			oauth2Field:           oauth2Attribute(),
+			workloadIdentityField: workloadIdentityAttribute(),
+			highCardinalityFieldsField: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Glob patterns of log fields known to have high cardinality, such as request or trace IDs. " +
+					"Planning an `oodle_logmetrics` resource with a label that takes its raw value, without a regex, " +
+					"from a matching field or from a matching key of a JSON field produces a warning. Defaults to `[\"*_id\", \"trace_id\", \"msg\"]`. " +
+					"Set to an empty list to disable the warning.",
+			},
 		},
 	}
 }
@@ -129,6 +202,37 @@ func (p *oodleProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(profileField),
+			"Unknown Oodle profile",
+			"The provider cannot create the Oodle API client as there is an unknown configuration value for the Oodle profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the OODLE_PROFILE environment variable.",
+		)
+	}
+
+	if config.CredentialProcess.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(credentialProcessField),
+			"Unknown Oodle credential process",
+			"The provider cannot create the Oodle API client as there is an unknown configuration value for the Oodle credential process. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.InstanceAPIKeys.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(instanceApiKeysField),
+			"Unknown Oodle instance API keys",
+			"The provider cannot create the Oodle API client as there is an unknown configuration value for the Oodle instance API keys. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	// Access tokens replace API keys when token authentication is configured.
+	tokenSource, diags := configuredTokenSource(ctx, config)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -152,6 +256,80 @@ func (p *oodleProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		apiKey = config.APIKey.ValueString()
 	}
 
+	// A credential process set in the configuration wins over API keys from
+	// the environment and the profile, which it would otherwise never get to
+	// replace. Setting both in the configuration is ambiguous.
+	configuredProcess := !config.CredentialProcess.IsNull() && config.CredentialProcess.ValueString() != ""
+	if configuredProcess {
+		if !config.APIKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(credentialProcessField),
+				"Conflicting Oodle Credentials",
+				"The provider configuration sets both api_key and credential_process. Set only one of them.",
+			)
+			return
+		}
+		apiKey = ""
+	}
+
+	// Fill in whatever is still missing from the selected profile.
+	profile, diags := loadProfile(config.Profile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if deployment == "" {
+		deployment = profile.DeploymentUrl
+	}
+
+	if instance == "" {
+		instance = profile.Instance
+	}
+
+	if apiKey == "" && !configuredProcess {
+		apiKey = profile.ApiKey
+	}
+
+	credentialProcess := profile.CredentialProcess
+	if !config.CredentialProcess.IsNull() {
+		credentialProcess = config.CredentialProcess.ValueString()
+	}
+
+	// The credential process only runs if no API key was found elsewhere, or
+	// was dropped in its favour above, and its settings apply last.
+	var credentialSource oodlehttp.CredentialSource
+	if tokenSource == nil && apiKey == "" && credentialProcess != "" {
+		credentialSource = oodlehttp.NewCredentialProcess(credentialProcess)
+		creds, err := credentialSource.Retrieve(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(credentialProcessField),
+				"Unable to Retrieve Oodle Credentials",
+				"The provider cannot create the Oodle API client as the credential process failed: "+err.Error(),
+			)
+			return
+		}
+
+		if deployment == "" {
+			deployment = creds.DeploymentUrl
+		}
+
+		if instance == "" {
+			instance = creds.Instance
+		}
+
+		apiKey = creds.ApiKey
+	}
+
+	var instanceApiKeys map[string]string
+	if !config.InstanceAPIKeys.IsNull() {
+		resp.Diagnostics.Append(config.InstanceAPIKeys.ElementsAs(ctx, &instanceApiKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	ctx = tflog.SetField(ctx, deploymentUrlField, deployment)
 	ctx = tflog.SetField(ctx, instanceField, instance)
 	ctx = tflog.SetField(ctx, apiKeyField, apiKey)
@@ -167,7 +345,7 @@ func (p *oodleProvider) Configure(ctx context.Context, req provider.ConfigureReq
 			path.Root(deploymentUrlField),
 			"Missing Oodle Deployment",
 			"The provider cannot create the Oodle API client as there is a missing or empty value for the Deployment. "+
-				"Set the deployment value in the configuration or use the OODLE_DEPLOYMENT environment variable. "+
+				"Set the deployment value in the configuration, use the OODLE_DEPLOYMENT environment variable, or set it in the Oodle profile. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -177,27 +355,51 @@ func (p *oodleProvider) Configure(ctx context.Context, req provider.ConfigureReq
 			path.Root(instanceField),
 			"Missing Oodle Instance",
 			"The provider cannot create the Oodle API client as there is a missing or empty value for the Oodle Instance. "+
-				"Set the instance value in the configuration or use the OODLE_INSTANCE environment variable. "+
+				"Set the instance value in the configuration, use the OODLE_INSTANCE environment variable, or set it in the Oodle profile. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if apiKey == "" {
+	if tokenSource == nil && apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root(apiKeyField),
 			"Missing Oodle API Key",
 			"The provider cannot create the Oodle API client as there is a missing or empty value for the Oodle API key. "+
-				"Set the api key value in the configuration or use the OODLE_API_KEY environment variable. "+
+				"Set the api key value in the configuration, use the OODLE_API_KEY environment variable, set it in the Oodle profile, or configure a credential process. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	highCardinalityFields, diags := configuredHighCardinalityFields(ctx, config.HighCardinalityFields)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Request a first token so that misconfigured token authentication is
+	// reported here rather than by every resource.
+	if tokenSource != nil {
+		if _, err := tokenSource.Token(); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Obtain Oodle Access Token",
+				"The provider cannot create the Oodle API client as requesting an access token failed: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Create a new Oodle client using the configuration values
-	client, err := oodlehttp.NewInstanceClient(deployment, instance, apiKey)
+	var client *oodlehttp.OodleApiClient
+	var err error
+	switch {
+	case tokenSource != nil:
+		client, err = oodlehttp.NewTokenClient(deployment, instance, tokenSource, instanceApiKeys)
+	case credentialSource != nil:
+		client, err = oodlehttp.NewCredentialsClient(deployment, instance, credentialSource, instanceApiKeys)
+	default:
+		client, err = oodlehttp.NewInstanceClient(deployment, instance, apiKey, instanceApiKeys)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Oodle API OodleApiClient",
@@ -210,8 +412,12 @@ func (p *oodleProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	// Make the Oodle client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	data := &providerdata.Data{
+		Client:                client,
+		HighCardinalityFields: highCardinalityFields,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 	tflog.Info(ctx, "Configured Oodle client", map[string]any{"success": true})
 }
 
@@ -222,6 +428,11 @@ func (p *oodleProvider) DataSources(_ context.Context) []func() datasource.DataS
 		dsNotifiers.NewNotifiersDataSource,
 		dsNotificationPolicies.NewNotificationPoliciesDataSource,
 		dsLogmetrics.NewLogmetricsDataSource,
+		logmetricspreview.NewLogmetricsPreviewDataSource,
+		logmetricsseries.NewLogmetricsSeriesDataSource,
+		metricdropruleimpact.NewMetricDropRuleImpactDataSource,
+		cardinalitylimitusage.NewCardinalityLimitUsageDataSource,
+		syntheticlocations.NewSyntheticLocationsDataSource,
 		dsGrafanaDashboards.NewGrafanaDashboardsDataSource,
 		dsGrafanaFolders.NewGrafanaFoldersDataSource,
 	}
@@ -234,10 +445,107 @@ func (p *oodleProvider) Resources(_ context.Context) []func() resource.Resource
 		notifier.NewNotifierResource,
 		notificationPolicy.NewNotificationPolicyResource,
 		logmetrics.NewLogMetricsResource,
+		logarchive.NewLogArchiveResource,
+		logdroprule.NewLogDropRuleResource,
+		logpipeline.NewLogPipelineResource,
+		logretentionpolicy.NewLogRetentionPolicyResource,
+		logview.NewLogViewResource,
+		cardinalitylimit.NewCardinalityLimitResource,
+		metricaggregationrule.NewMetricAggregationRuleResource,
 		metricdroprule.NewMetricDropRuleResource,
+		metricrelabelrule.NewMetricRelabelRuleResource,
 		grafanafolder.NewGrafanaFolderResource,
 		grafanadashboard.NewGrafanaDashboardResource,
 		syntheticmonitor.NewSyntheticMonitorResource,
+		syntheticprivatelocation.NewSyntheticPrivateLocationResource,
+		syntheticvariable.NewSyntheticVariableResource,
 		awsintegration.NewAwsIntegrationResource,
 	}
 }
+
+// Functions defines the functions implemented in the provider.
+func (p *oodleProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		logmetricspreview.NewLogmetricsPreviewFunction,
+	}
+}
+
+// configuredHighCardinalityFields returns the configured high cardinality field
+// patterns, or the default patterns if none are configured.
+func configuredHighCardinalityFields(ctx context.Context, value types.List) ([]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultHighCardinalityFields, nil
+	}
+
+	fields := []string{}
+	diags := value.ElementsAs(ctx, &fields, false)
+	for _, field := range fields {
+		if _, err := stdpath.Match(field, ""); err != nil {
+			diags.AddAttributeError(
+				path.Root(highCardinalityFieldsField),
+				"Invalid high cardinality field pattern",
+				fmt.Sprintf("Pattern %q is not a valid glob pattern: %v", field, err),
+			)
+		}
+	}
+	return fields, diags
+}
+
+// loadProfile returns the profile selected by the configuration or the
+// OODLE_PROFILE environment variable. Without a selection, the default profile
+// is returned if the credentials file has one, and an empty profile otherwise.
+func loadProfile(configProfile types.String) (oodlehttp.Profile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := os.Getenv("OODLE_PROFILE")
+	if !configProfile.IsNull() {
+		name = configProfile.ValueString()
+	}
+	explicit := name != ""
+	if !explicit {
+		name = oodlehttp.DefaultProfile
+	}
+
+	file := os.Getenv("OODLE_CREDENTIALS_FILE")
+	if file == "" {
+		var err error
+		file, err = oodlehttp.DefaultCredentialsFile()
+		if err != nil && explicit {
+			diags.AddAttributeError(
+				path.Root(profileField),
+				"Unable to Locate Oodle Credentials File",
+				"The provider cannot find the home directory holding the Oodle credentials file. "+
+					"Set the OODLE_CREDENTIALS_FILE environment variable to its path.\n\n"+
+					"Error: "+err.Error(),
+			)
+		}
+		if err != nil {
+			return oodlehttp.Profile{}, diags
+		}
+	}
+
+	profiles, err := oodlehttp.LoadProfiles(file)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return oodlehttp.Profile{}, diags
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(profileField),
+			"Unable to Read Oodle Credentials File",
+			"The provider cannot read the Oodle credentials file "+file+".\n\n"+
+				"Error: "+err.Error(),
+		)
+		return oodlehttp.Profile{}, diags
+	}
+
+	profile, ok := profiles[name]
+	if !ok && explicit {
+		diags.AddAttributeError(
+			path.Root(profileField),
+			"Missing Oodle Profile",
+			"The Oodle credentials file "+file+" has no profile named "+name+".",
+		)
+	}
+
+	return profile, diags
+}
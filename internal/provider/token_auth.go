@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"golang.org/x/oauth2"
+
+	"terraform-provider-oodle/internal/oodlehttp"
+)
+
+const (
+	oauth2Field           = "oauth2"
+	workloadIdentityField = "workload_identity"
+)
+
+// oauth2Model configures the OAuth2 client credentials grant.
+type oauth2Model struct {
+	TokenUrl     types.String   `tfsdk:"token_url"`
+	ClientId     types.String   `tfsdk:"client_id"`
+	ClientSecret types.String   `tfsdk:"client_secret"`
+	Scopes       []types.String `tfsdk:"scopes"`
+	Audience     types.String   `tfsdk:"audience"`
+}
+
+// workloadIdentityModel configures the exchange of a workload identity token
+// for an Oodle access token.
+type workloadIdentityModel struct {
+	TokenUrl         types.String   `tfsdk:"token_url"`
+	Audience         types.String   `tfsdk:"audience"`
+	Scopes           []types.String `tfsdk:"scopes"`
+	ClientId         types.String   `tfsdk:"client_id"`
+	SubjectTokenFile types.String   `tfsdk:"subject_token_file"`
+	SubjectTokenEnv  types.String   `tfsdk:"subject_token_env"`
+	SubjectTokenType types.String   `tfsdk:"subject_token_type"`
+}
+
+func oauth2Attribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: "Authenticate with access tokens obtained through the OAuth2 client credentials grant " +
+			"instead of an API key. Tokens are refreshed when they expire.",
+		Attributes: map[string]schema.Attribute{
+			"token_url": schema.StringAttribute{
+				Required:    true,
+				Description: "URL of the token endpoint.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Client ID. Can also be set with the `OODLE_CLIENT_ID` environment variable.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client secret. Can also be set with the `OODLE_CLIENT_SECRET` environment variable.",
+			},
+			"scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Scopes to request.",
+			},
+			"audience": schema.StringAttribute{
+				Optional:    true,
+				Description: "Audience to request the token for.",
+			},
+		},
+	}
+}
+
+func workloadIdentityAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: "Authenticate by exchanging a workload identity token, such as the OIDC token of a CI job " +
+			"or a Kubernetes service account token, for an access token (RFC 8693). The token is exchanged " +
+			"again when the access token expires.",
+		Attributes: map[string]schema.Attribute{
+			"token_url": schema.StringAttribute{
+				Required:    true,
+				Description: "URL of the token exchange endpoint.",
+			},
+			"audience": schema.StringAttribute{
+				Optional:    true,
+				Description: "Audience to request the access token for.",
+			},
+			"scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Scopes to request.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Client ID to send with the exchange, if the endpoint requires one.",
+			},
+			"subject_token_file": schema.StringAttribute{
+				Optional: true,
+				Description: "File holding the workload identity token. The file is read again before every " +
+					"exchange so rotated tokens are picked up. Exactly one of `subject_token_file` and " +
+					"`subject_token_env` must be set.",
+			},
+			"subject_token_env": schema.StringAttribute{
+				Optional:    true,
+				Description: "Environment variable holding the workload identity token.",
+			},
+			"subject_token_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Type of the workload identity token. Defaults to " +
+					"`" + oodlehttp.DefaultSubjectTokenType + "`.",
+			},
+		},
+	}
+}
+
+// configuredTokenSource returns the source of access tokens configured by the oauth2 or
+// workload_identity attributes, or nil if neither is set.
+func configuredTokenSource(ctx context.Context, config oodleProviderModel) (oauth2.TokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.OAuth2.IsUnknown() || config.WorkloadIdentity.IsUnknown() {
+		diags.AddError(
+			"Unknown Oodle token authentication",
+			"The provider cannot create the Oodle API client as there is an unknown configuration value for oauth2 or workload_identity. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return nil, diags
+	}
+
+	if !config.OAuth2.IsNull() && !config.WorkloadIdentity.IsNull() {
+		diags.AddAttributeError(
+			path.Root(workloadIdentityField),
+			"Conflicting Oodle authentication",
+			"Only one of oauth2 and workload_identity can be set.",
+		)
+		return nil, diags
+	}
+	if (!config.OAuth2.IsNull() || !config.WorkloadIdentity.IsNull()) && !config.APIKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root(apiKeyField),
+			"Conflicting Oodle authentication",
+			"api_key cannot be set together with oauth2 or workload_identity.",
+		)
+		return nil, diags
+	}
+
+	switch {
+	case !config.OAuth2.IsNull():
+		var m oauth2Model
+		diags.Append(config.OAuth2.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		return clientCredentialsSource(m)
+	case !config.WorkloadIdentity.IsNull():
+		var m workloadIdentityModel
+		diags.Append(config.WorkloadIdentity.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		return tokenExchangeSource(m)
+	}
+
+	return nil, diags
+}
+
+func clientCredentialsSource(m oauth2Model) (oauth2.TokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	clientId := os.Getenv("OODLE_CLIENT_ID")
+	if !m.ClientId.IsNull() {
+		clientId = m.ClientId.ValueString()
+	}
+
+	clientSecret := os.Getenv("OODLE_CLIENT_SECRET")
+	if !m.ClientSecret.IsNull() {
+		clientSecret = m.ClientSecret.ValueString()
+	}
+
+	if clientId == "" {
+		diags.AddAttributeError(
+			path.Root(oauth2Field).AtName("client_id"),
+			"Missing Oodle OAuth2 Client ID",
+			"Set the client_id value in the configuration or use the OODLE_CLIENT_ID environment variable.",
+		)
+	}
+
+	if clientSecret == "" {
+		diags.AddAttributeError(
+			path.Root(oauth2Field).AtName("client_secret"),
+			"Missing Oodle OAuth2 Client Secret",
+			"Set the client_secret value in the configuration or use the OODLE_CLIENT_SECRET environment variable.",
+		)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return oodlehttp.NewClientCredentialsSource(oodlehttp.ClientCredentialsConfig{
+		TokenUrl:     m.TokenUrl.ValueString(),
+		ClientId:     clientId,
+		ClientSecret: clientSecret,
+		Scopes:       stringValues(m.Scopes),
+		Audience:     m.Audience.ValueString(),
+	}), diags
+}
+
+func tokenExchangeSource(m workloadIdentityModel) (oauth2.TokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var subjectToken func() (string, error)
+	switch {
+	case !m.SubjectTokenFile.IsNull() && !m.SubjectTokenEnv.IsNull():
+		diags.AddAttributeError(
+			path.Root(workloadIdentityField).AtName("subject_token_env"),
+			"Conflicting Oodle Workload Identity Token",
+			"Only one of subject_token_file and subject_token_env can be set.",
+		)
+	case !m.SubjectTokenFile.IsNull():
+		subjectToken = oodlehttp.SubjectTokenFromFile(m.SubjectTokenFile.ValueString())
+	case !m.SubjectTokenEnv.IsNull():
+		subjectToken = oodlehttp.SubjectTokenFromEnv(m.SubjectTokenEnv.ValueString())
+	default:
+		diags.AddAttributeError(
+			path.Root(workloadIdentityField),
+			"Missing Oodle Workload Identity Token",
+			"One of subject_token_file and subject_token_env must be set.",
+		)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return oodlehttp.NewTokenExchangeSource(oodlehttp.TokenExchangeConfig{
+		TokenUrl:         m.TokenUrl.ValueString(),
+		Audience:         m.Audience.ValueString(),
+		Scopes:           stringValues(m.Scopes),
+		ClientId:         m.ClientId.ValueString(),
+		SubjectTokenType: m.SubjectTokenType.ValueString(),
+		SubjectToken:     subjectToken,
+	}), diags
+}
+
+func stringValues(values []types.String) []string {
+	var res []string
+	for _, v := range values {
+		res = append(res, v.ValueString())
+	}
+	return res
+}
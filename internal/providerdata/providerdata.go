@@ -0,0 +1,18 @@
+// Package providerdata holds the data that the provider passes to its
+// resources and data sources when they are configured.
+package providerdata
+
+import (
+	"terraform-provider-oodle/internal/oodlehttp"
+)
+
+// Data is the provider data of resources and data sources.
+type Data struct {
+	// Client is the Oodle API client of the provider's default instance.
+	Client *oodlehttp.OodleApiClient
+
+	// HighCardinalityFields are glob patterns of log fields known to have
+	// high cardinality. oodle_logmetrics warns at plan time when it would
+	// generate metric labels from matching fields.
+	HighCardinalityFields []string
+}
@@ -0,0 +1,22 @@
+package resourceutils
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// InstanceModel holds the optional instance override of a resource or data
+// source. It is embedded by value in resource and data source models.
+type InstanceModel struct {
+	Instance types.String `tfsdk:"instance"`
+}
+
+// GetInstance returns the instance the resource belongs to. A null value
+// means the provider's default instance.
+func (m *InstanceModel) GetInstance() types.String {
+	return m.Instance
+}
+
+// SetInstance sets the instance the resource belongs to.
+func (m *InstanceModel) SetInstance(instance types.String) {
+	m.Instance = instance
+}
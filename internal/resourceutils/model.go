@@ -30,4 +30,20 @@ type ResourceModel[M clientmodels.ClientModel] interface {
 
 	// GetID returns the ID of the resource model.
 	GetID() types.String
+
+	// GetInstance returns the instance override of the resource model.
+	GetInstance() types.String
+
+	// SetInstance sets the instance override of the resource model.
+	SetInstance(instance types.String)
+}
+
+// ConfiguredFormPreserver is implemented by resource models with attributes
+// that can be configured in more than one form, such as a filter configured
+// either as a filter_query or as a structured filter. PreserveConfigured is
+// called before FromClientModel with the model the resource was planned or
+// read as, so that the attributes are converted in the form they were
+// configured in.
+type ConfiguredFormPreserver[R any] interface {
+	PreserveConfigured(prior R)
 }
@@ -0,0 +1,307 @@
+// Package rustregex checks that patterns are valid in the dialect of the Rust
+// regex crate, which Oodle uses to evaluate log filters and extractors.
+//
+// The Rust dialect is close to Go's RE2 syntax, but differs in a few places
+// that matter when validating patterns offline:
+//   - look-around, backreferences, atomic groups, possessive quantifiers,
+//     conditionals, comments and \Q...\E quoting are not supported;
+//   - a '{' that does not start a counted repetition must be escaped;
+//   - named groups are written (?P<name>...) or (?<name>...);
+//   - the x (verbose), u and R flags, \< and \> word boundaries, \u escapes
+//     and nested character class set operations are supported, but Go cannot
+//     parse them, so patterns using them are only checked by this package.
+package rustregex
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// Error describes why a pattern is not a valid Rust regex.
+type Error struct {
+	// Pattern is the pattern that failed to parse.
+	Pattern string
+
+	// Offset is the byte offset of the problem in the pattern.
+	Offset int
+
+	// Msg describes the problem.
+	Msg string
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v at column %d", e.Msg, e.Offset+1)
+}
+
+var (
+	countedRepetition = regexp.MustCompile(`^\{\s*\d+\s*(,\s*\d*\s*)?\}`)
+	groupName         = regexp.MustCompile(`^[_A-Za-z][_A-Za-z0-9.\[\]]*$`)
+	goGroupName       = regexp.MustCompile(`^[_A-Za-z0-9]+$`)
+)
+
+// Groups describes the capture groups of a regex.
+type Groups struct {
+	// Count is the number of capture groups, named or not.
+	Count int
+
+	// Names are the names of the named capture groups, in order.
+	Names []string
+}
+
+// Check returns the capture groups of a Rust regex, or an error if the
+// pattern is not valid in the Rust dialect.
+func Check(pattern string) (Groups, error) {
+	c := checker{pattern: pattern}
+	if err := c.scan(); err != nil {
+		return Groups{}, err
+	}
+
+	if !c.rustOnly {
+		if _, err := syntax.Parse(pattern, syntax.Perl); err != nil {
+			msg := err.Error()
+			if syntaxErr, ok := err.(*syntax.Error); ok {
+				msg = fmt.Sprintf("%v: `%v`", syntaxErr.Code, syntaxErr.Expr)
+			}
+			return Groups{}, fmt.Errorf("invalid regex: %v", msg)
+		}
+	}
+	return c.groups, nil
+}
+
+type checker struct {
+	pattern string
+	pos     int
+
+	// groups are the capture groups found so far.
+	groups Groups
+
+	// verbose is set once the x flag is used, after which '#' starts a
+	// comment that runs to the end of the line.
+	verbose bool
+
+	// rustOnly is set when the pattern uses syntax that Rust supports but
+	// Go does not, so it cannot be checked further with regexp/syntax.
+	rustOnly bool
+}
+
+func (c *checker) errorf(offset int, format string, args ...any) error {
+	return &Error{Pattern: c.pattern, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (c *checker) rest() string {
+	return c.pattern[c.pos:]
+}
+
+func (c *checker) scan() error {
+	for c.pos < len(c.pattern) {
+		start := c.pos
+		switch c.pattern[c.pos] {
+		case '#':
+			if c.verbose {
+				if end := strings.IndexByte(c.rest(), '\n'); end >= 0 {
+					c.pos += end
+				} else {
+					c.pos = len(c.pattern)
+				}
+				continue
+			}
+		case '\\':
+			if err := c.escape(false); err != nil {
+				return err
+			}
+			continue
+		case '[':
+			if err := c.class(); err != nil {
+				return err
+			}
+			continue
+		case '(':
+			if err := c.group(); err != nil {
+				return err
+			}
+			continue
+		case '{':
+			m := countedRepetition.FindString(c.rest())
+			if m == "" {
+				return c.errorf(start, "a literal '{' must be escaped as '\\{'")
+			}
+			c.pos += len(m)
+			if err := c.possessive(); err != nil {
+				return err
+			}
+			continue
+		case '*', '+', '?':
+			c.pos++
+			if err := c.possessive(); err != nil {
+				return err
+			}
+			continue
+		}
+		c.pos++
+	}
+	return nil
+}
+
+// possessive rejects a '+' directly after a repetition operator. A '?' is
+// allowed, as it makes the repetition lazy.
+func (c *checker) possessive() error {
+	if strings.HasPrefix(c.rest(), "+") {
+		return c.errorf(c.pos, "possessive quantifiers are not supported")
+	}
+	if strings.HasPrefix(c.rest(), "?") {
+		c.pos++
+	}
+	return nil
+}
+
+// escape checks the escape sequence at the current position.
+func (c *checker) escape(inClass bool) error {
+	start := c.pos
+	if c.pos+1 >= len(c.pattern) {
+		return c.errorf(start, "incomplete escape sequence")
+	}
+	next := c.pattern[c.pos+1]
+	c.pos += 2
+
+	if strings.IndexByte("pPxuU", next) >= 0 && strings.HasPrefix(c.rest(), "{") {
+		// Braced escapes like \p{Greek} or \x{1F600}.
+		end := strings.IndexByte(c.rest(), '}')
+		if end < 0 {
+			return c.errorf(start, "unclosed escape sequence")
+		}
+		c.pos += end + 1
+	}
+
+	switch {
+	case next >= '0' && next <= '9':
+		return c.errorf(start, "backreferences are not supported")
+	case next == 'k' || next == 'g':
+		return c.errorf(start, "backreferences are not supported")
+	case next == 'Q' || next == 'E':
+		return c.errorf(start, "\\Q...\\E quoting is not supported, escape each character instead")
+	case next == 'u' || next == 'U' || next == 'e':
+		c.rustOnly = true
+	case !inClass && (next == '<' || next == '>'):
+		c.rustOnly = true
+	case !inClass && next == 'b' && strings.HasPrefix(c.rest(), "{"):
+		// Word boundary assertions like \b{start}.
+		end := strings.IndexByte(c.rest(), '}')
+		if end < 0 {
+			return c.errorf(start, "unclosed word boundary assertion")
+		}
+		c.pos += end + 1
+		c.rustOnly = true
+	}
+	return nil
+}
+
+// class checks the character class at the current position, including nested
+// classes and set operations.
+func (c *checker) class() error {
+	start := c.pos
+	depth := 0
+	for c.pos < len(c.pattern) {
+		rest := c.rest()
+		switch {
+		case depth > 0 && strings.HasPrefix(rest, "[:") && strings.Contains(rest, ":]"):
+			// ASCII classes like [:alpha:].
+			c.pos += strings.Index(rest, ":]") + 2
+		case rest[0] == '[':
+			if depth > 0 {
+				// Nested classes are only supported by Rust.
+				c.rustOnly = true
+			}
+			depth++
+			c.pos++
+			c.pos += len(c.rest()) - len(strings.TrimPrefix(c.rest(), "^"))
+			// A ']' at the start of a class is a literal.
+			c.pos += len(c.rest()) - len(strings.TrimPrefix(c.rest(), "]"))
+		case rest[0] == ']':
+			depth--
+			c.pos++
+			if depth == 0 {
+				return nil
+			}
+		case rest[0] == '\\':
+			if err := c.escape(true); err != nil {
+				return err
+			}
+		case strings.HasPrefix(rest, "&&"), strings.HasPrefix(rest, "~~"), strings.HasPrefix(rest, "--"):
+			// Set operations. Go parses "--" as a range instead.
+			c.rustOnly = true
+			c.pos += 2
+		default:
+			c.pos++
+		}
+	}
+	return c.errorf(start, "unclosed character class")
+}
+
+// group checks the group opening at the current position.
+func (c *checker) group() error {
+	start := c.pos
+	c.pos++
+	if !strings.HasPrefix(c.rest(), "?") {
+		c.groups.Count++
+		return nil
+	}
+	c.pos++
+
+	rest := c.rest()
+	switch {
+	case strings.HasPrefix(rest, "="), strings.HasPrefix(rest, "!"):
+		return c.errorf(start, "look-ahead is not supported")
+	case strings.HasPrefix(rest, "<="), strings.HasPrefix(rest, "<!"):
+		return c.errorf(start, "look-behind is not supported")
+	case strings.HasPrefix(rest, ">"):
+		return c.errorf(start, "atomic groups are not supported")
+	case strings.HasPrefix(rest, "("):
+		return c.errorf(start, "conditionals are not supported")
+	case strings.HasPrefix(rest, "#"):
+		return c.errorf(start, "comments are not supported, use the x flag instead")
+	case strings.HasPrefix(rest, "P="), strings.HasPrefix(rest, "P>"):
+		return c.errorf(start, "backreferences are not supported")
+	case strings.HasPrefix(rest, "'"):
+		return c.errorf(start, "named groups must be written as (?P<name>...) or (?<name>...)")
+	case strings.HasPrefix(rest, "P<"), strings.HasPrefix(rest, "<"):
+		c.pos += strings.IndexByte(rest, '<') + 1
+		end := strings.IndexByte(c.rest(), '>')
+		if end < 0 {
+			return c.errorf(start, "unclosed group name")
+		}
+		name := c.rest()[:end]
+		if !groupName.MatchString(name) {
+			return c.errorf(start, "invalid group name %q: names must start with a letter or '_'", name)
+		}
+		if !goGroupName.MatchString(name) {
+			c.rustOnly = true
+		}
+		c.pos += end + 1
+		c.groups.Count++
+		c.groups.Names = append(c.groups.Names, name)
+		return nil
+	}
+
+	// Flags, as in (?i) or (?i-s:...).
+	for c.pos < len(c.pattern) {
+		flag := c.pattern[c.pos]
+		switch flag {
+		case ')', ':':
+			c.pos++
+			return nil
+		case 'i', 'm', 's', 'U', '-':
+		case 'x':
+			c.verbose = true
+			c.rustOnly = true
+		case 'u', 'R':
+			c.rustOnly = true
+		default:
+			return c.errorf(c.pos, "unrecognized flag %q", flag)
+		}
+		c.pos++
+	}
+	return c.errorf(start, "unclosed group")
+}
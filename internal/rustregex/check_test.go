@@ -0,0 +1,95 @@
+package rustregex
+
+import (
+	"testing"
+
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		pattern string
+		groups  int
+	}{
+		{pattern: `step=(\w+)`, groups: 1},
+		{pattern: `^\d{3}-\d{2,}$`, groups: 0},
+		{pattern: `(?P<user>[a-z]+)@(?<domain>[a-z.]+)`, groups: 2},
+		{pattern: `(?:GET|POST) (/\S*)`, groups: 1},
+		{pattern: `(?i)duration=(\d+(?:\.\d+)?)ms`, groups: 1},
+		{pattern: `latency: (\d+?) \{ms\}`, groups: 1},
+		{pattern: `\p{Greek}+ \x{1F600} [[:alpha:]]`, groups: 0},
+		{pattern: `[a-z&&[^aeiou]]+`, groups: 0},
+		{pattern: "(?x) (\\d+) # the value {\n ms", groups: 1},
+		{pattern: `\bword\b \<start`, groups: 0},
+		{pattern: `(?P<a.b>x)`, groups: 1},
+		{pattern: `[(]not a group[)]`, groups: 0},
+		{pattern: `[]a]`, groups: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			groups, err := Check(tt.pattern)
+			assert.Nil(t, err)
+			assert.Equal(t, groups.Count, tt.groups)
+		})
+	}
+}
+
+func TestCheckGroupNames(t *testing.T) {
+	tests := []struct {
+		pattern string
+		names   []string
+	}{
+		{pattern: `(?P<user>[a-z]+)@(?<domain>[a-z.]+)`, names: []string{"user", "domain"}},
+		{pattern: `(\d+) (?P<unit>ms|s)`, names: []string{"unit"}},
+		{pattern: `(?P<a.b>x)`, names: []string{"a.b"}},
+		{pattern: `step=(\w+)`, names: nil},
+		{pattern: `\(?P<x>\)`, names: nil},
+		{pattern: `[(?P<x>)]+`, names: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			groups, err := Check(tt.pattern)
+			assert.Nil(t, err)
+			assert.DeepEqual(t, groups.Names, tt.names)
+		})
+	}
+}
+
+func TestCheckErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr string
+	}{
+		{pattern: `foo(?=bar)`, wantErr: "look-ahead is not supported at column 4"},
+		{pattern: `foo(?!bar)`, wantErr: "look-ahead is not supported at column 4"},
+		{pattern: `(?<=foo)bar`, wantErr: "look-behind is not supported at column 1"},
+		{pattern: `(?<!foo)bar`, wantErr: "look-behind is not supported at column 1"},
+		{pattern: `(a)\1`, wantErr: "backreferences are not supported at column 4"},
+		{pattern: `(?P<a>x)\k<a>`, wantErr: "backreferences are not supported at column 9"},
+		{pattern: `(?P<a>x)(?P=a)`, wantErr: "backreferences are not supported at column 9"},
+		{pattern: `(?>a+)b`, wantErr: "atomic groups are not supported at column 1"},
+		{pattern: `a++`, wantErr: "possessive quantifiers are not supported at column 3"},
+		{pattern: `a{2}+`, wantErr: "possessive quantifiers are not supported at column 5"},
+		{pattern: `\Q.*\E`, wantErr: `\Q...\E quoting is not supported, escape each character instead at column 1`},
+		{pattern: `{"level":"(\w+)"}`, wantErr: `a literal '{' must be escaped as '\{' at column 1`},
+		{pattern: `(?'name'x)`, wantErr: "named groups must be written as (?P<name>...) or (?<name>...) at column 1"},
+		{pattern: `(?P<1a>x)`, wantErr: `invalid group name "1a": names must start with a letter or '_' at column 1`},
+		{pattern: `(?P<a`, wantErr: "unclosed group name at column 1"},
+		{pattern: `(?z)a`, wantErr: `unrecognized flag 'z' at column 3`},
+		{pattern: `[a-z`, wantErr: "unclosed character class at column 1"},
+		{pattern: `(a`, wantErr: "invalid regex: missing closing ): `(a`"},
+		{pattern: `a**`, wantErr: "invalid regex: invalid nested repetition operator: `**`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			_, err := Check(tt.pattern)
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", tt.wantErr)
+			}
+			assert.Equal(t, err.Error(), tt.wantErr)
+		})
+	}
+}
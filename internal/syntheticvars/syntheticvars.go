@@ -0,0 +1,39 @@
+// Package syntheticvars handles the variables that synthetic monitors
+// interpolate into their requests with {{VAR_NAME}} placeholders.
+//
+// A placeholder references either a variable extracted by an earlier step of a
+// multi-step monitor, or a global variable managed with the
+// oodle_synthetic_variable resource. Both share the same naming rules: names
+// are uppercase, start with a letter and are at least 3 characters long.
+package syntheticvars
+
+import (
+	"regexp"
+)
+
+var (
+	// NamePattern matches valid variable names.
+	NamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{2,}$`)
+
+	placeholder = regexp.MustCompile(`\{\{([^{}]*)\}\}`)
+)
+
+// ValidName reports whether name is a valid variable name.
+func ValidName(name string) bool {
+	return NamePattern.MatchString(name)
+}
+
+// Reference returns the placeholder that references the variable name.
+func Reference(name string) string {
+	return "{{" + name + "}}"
+}
+
+// References returns the names referenced by the placeholders in s, in order
+// of appearance. Names are returned as written, and may not be valid.
+func References(s string) []string {
+	var names []string
+	for _, match := range placeholder.FindAllStringSubmatch(s, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
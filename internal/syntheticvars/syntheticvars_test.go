@@ -0,0 +1,24 @@
+package syntheticvars
+
+import (
+	"testing"
+
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestValidName(t *testing.T) {
+	for _, name := range []string{"API", "ACCESS_TOKEN", "USER_ID2", "A_B"} {
+		assert.True(t, ValidName(name))
+	}
+	for _, name := range []string{"", "ID", "token", "Access_Token", "1ST_ID", "_TOKEN", "API-KEY"} {
+		assert.False(t, ValidName(name))
+	}
+}
+
+func TestReferences(t *testing.T) {
+	assert.Equal(t, len(References("https://example.com/health")), 0)
+	assert.DeepEqual(t, References("https://{{HOST}}/users/{{USER_ID}}?token={{HOST}}"),
+		[]string{"HOST", "USER_ID", "HOST"})
+	assert.DeepEqual(t, References(`{"id": "{{ user }}", "raw": "{not} {{}}"}`), []string{" user ", ""})
+	assert.Equal(t, Reference("API_TOKEN"), "{{API_TOKEN}}")
+}
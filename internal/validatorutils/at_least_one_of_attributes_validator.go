@@ -0,0 +1,53 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type atLeastOneOfAttributesValidator struct {
+	paths []path.Path
+}
+
+var _ resource.ConfigValidator = (*atLeastOneOfAttributesValidator)(nil)
+
+// NewAtLeastOneOfAttributesValidator returns a resource validator that fails
+// when none of the attributes at paths is set.
+func NewAtLeastOneOfAttributesValidator(paths ...path.Path) resource.ConfigValidator {
+	return &atLeastOneOfAttributesValidator{paths: paths}
+}
+
+func (v atLeastOneOfAttributesValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Validates that at least one of %v is set", pathNames(v.paths))
+}
+
+func (v atLeastOneOfAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v atLeastOneOfAttributesValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	for _, p := range v.paths {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value != nil && !value.IsNull() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		v.paths[0],
+		"Missing attribute",
+		fmt.Sprintf("At least one of %v must be set.", pathNames(v.paths)),
+	)
+}
@@ -0,0 +1,58 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestAtLeastOneOfAttributesValidator(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"a": schema.StringAttribute{Optional: true},
+			"b": schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"a": tftypes.String,
+		"b": tftypes.String,
+	}}
+
+	tests := []struct {
+		name    string
+		a       any
+		b       any
+		wantErr bool
+	}{
+		{name: "none set", wantErr: true},
+		{name: "a set", a: "x"},
+		{name: "b set", b: "y"},
+		{name: "both set", a: "x", b: "y"},
+		{name: "unknown", b: tftypes.UnknownValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"a": tftypes.NewValue(tftypes.String, tt.a),
+						"b": tftypes.NewValue(tftypes.String, tt.b),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewAtLeastOneOfAttributesValidator(path.Root("a"), path.Root("b")).ValidateResource(ctx, req, resp)
+			assert.Equal(t, resp.Diagnostics.HasError(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/metricselector"
+)
+
+// cardinalityLimitPerLabelValidator validates that per_label of a cardinality
+// limit is not the metric name label, as limits already apply to each metric.
+type cardinalityLimitPerLabelValidator struct{}
+
+var _ resource.ConfigValidator = (*cardinalityLimitPerLabelValidator)(nil)
+
+func NewCardinalityLimitPerLabelValidator() resource.ConfigValidator {
+	return &cardinalityLimitPerLabelValidator{}
+}
+
+func (v cardinalityLimitPerLabelValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Validates that per_label is not %v.", metricselector.MetricNameLabel)
+}
+
+func (v cardinalityLimitPerLabelValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cardinalityLimitPerLabelValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var perLabel types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("per_label"), &perLabel)...)
+	if resp.Diagnostics.HasError() || perLabel.IsNull() || perLabel.IsUnknown() {
+		return
+	}
+
+	if perLabel.ValueString() == metricselector.MetricNameLabel {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("per_label"),
+			"Invalid per_label",
+			fmt.Sprintf("per_label cannot be %v, as limits already apply to each metric.", metricselector.MetricNameLabel),
+		)
+	}
+}
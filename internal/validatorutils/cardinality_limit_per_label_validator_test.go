@@ -0,0 +1,59 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestCardinalityLimitPerLabelValidator(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"per_label": schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"per_label": tftypes.String,
+	}}
+
+	tests := []struct {
+		name     string
+		perLabel any
+		wantErr  bool
+	}{
+		{name: "not set"},
+		{name: "label", perLabel: "service"},
+		{name: "unknown", perLabel: tftypes.UnknownValue},
+		{name: "metric name label", perLabel: "__name__", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"per_label": tftypes.NewValue(tftypes.String, tt.perLabel),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewCardinalityLimitPerLabelValidator().ValidateResource(ctx, req, resp)
+			assert.Equal(t, resp.Diagnostics.HasError(), tt.wantErr)
+			if tt.wantErr {
+				withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				assert.True(t, ok)
+				assert.True(t, withPath.Path().Equal(path.Root("per_label")))
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type conflictingAttributesValidator struct {
+	paths []path.Path
+}
+
+var _ resource.ConfigValidator = (*conflictingAttributesValidator)(nil)
+
+// NewConflictingAttributesValidator returns a resource validator that fails
+// when more than one of the attributes at paths is set.
+func NewConflictingAttributesValidator(paths ...path.Path) resource.ConfigValidator {
+	return &conflictingAttributesValidator{paths: paths}
+}
+
+func (v conflictingAttributesValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Validates that at most one of %v is set", v.names())
+}
+
+func (v conflictingAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v conflictingAttributesValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var set []path.Path
+	for _, p := range v.paths {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value != nil && !value.IsNull() {
+			set = append(set, p)
+		}
+	}
+
+	if len(set) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			set[len(set)-1],
+			"Conflicting attributes",
+			fmt.Sprintf("Only one of %v can be set.", v.names()),
+		)
+	}
+}
+
+func (v conflictingAttributesValidator) names() string {
+	return pathNames(v.paths)
+}
+
+// pathNames formats paths as a comma-separated list.
+func pathNames(paths []path.Path) string {
+	names := make([]string, len(paths))
+	for i, p := range paths {
+		names[i] = p.String()
+	}
+	return strings.Join(names, ", ")
+}
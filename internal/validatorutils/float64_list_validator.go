@@ -0,0 +1,84 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type float64ListValidator struct {
+	// increasing requires each value to be greater than the one before it.
+	increasing bool
+
+	// quantiles requires each value to be between 0 and 1, exclusive, and
+	// to be listed once.
+	quantiles bool
+}
+
+var _ validator.List = (*float64ListValidator)(nil)
+
+// NewIncreasingFloat64ListValidator validates that a list of numbers is in
+// strictly increasing order.
+func NewIncreasingFloat64ListValidator() validator.List {
+	return &float64ListValidator{increasing: true}
+}
+
+// NewQuantilesValidator validates that a list of numbers holds distinct
+// quantiles between 0 and 1, exclusive.
+func NewQuantilesValidator() validator.List {
+	return &float64ListValidator{quantiles: true}
+}
+
+func (v float64ListValidator) Description(_ context.Context) string {
+	if v.quantiles {
+		return "Validates that the list holds distinct quantiles between 0 and 1"
+	}
+	return "Validates that the list is in increasing order"
+}
+
+func (v float64ListValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64ListValidator) ValidateList(_ context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var prev types.Float64
+	seen := map[float64]struct{}{}
+	for i, element := range request.ConfigValue.Elements() {
+		value, ok := element.(types.Float64)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			prev = types.Float64Unknown()
+			continue
+		}
+		current := value.ValueFloat64()
+
+		if v.increasing && !prev.IsNull() && !prev.IsUnknown() && current <= prev.ValueFloat64() {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtListIndex(i),
+				"Invalid value",
+				fmt.Sprintf("The values must be in increasing order, got %v after %v.", current, prev.ValueFloat64()))
+		}
+		prev = value
+
+		if v.quantiles {
+			if current <= 0 || current >= 1 {
+				response.Diagnostics.AddAttributeError(
+					request.Path.AtListIndex(i),
+					"Invalid quantile",
+					fmt.Sprintf("The quantile %v must be greater than 0 and less than 1.", current))
+			}
+			if _, ok := seen[current]; ok {
+				response.Diagnostics.AddAttributeError(
+					request.Path.AtListIndex(i),
+					"Invalid quantile",
+					fmt.Sprintf("The quantile %v is listed more than once.", current))
+			}
+			seen[current] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package validatorutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func float64List(values ...attr.Value) types.List {
+	return types.ListValueMust(types.Float64Type, values)
+}
+
+func TestIncreasingFloat64ListValidator(t *testing.T) {
+	validator := NewIncreasingFloat64ListValidator()
+
+	assert.True(t, isValidList(float64List(types.Float64Value(1), types.Float64Value(2), types.Float64Value(4)), validator))
+	assert.True(t, isValidList(float64List(types.Float64Value(1), types.Float64Unknown(), types.Float64Value(0.5)), validator))
+	assert.False(t, isValidList(float64List(types.Float64Value(1), types.Float64Value(4), types.Float64Value(2)), validator))
+	assert.False(t, isValidList(float64List(types.Float64Value(1), types.Float64Value(1)), validator))
+
+	assert.True(t, isValidList(types.ListNull(types.Float64Type), validator))
+	assert.True(t, isValidList(types.ListUnknown(types.Float64Type), validator))
+}
+
+func TestQuantilesValidator(t *testing.T) {
+	validator := NewQuantilesValidator()
+
+	assert.True(t, isValidList(float64List(types.Float64Value(0.5), types.Float64Value(0.99)), validator))
+	assert.True(t, isValidList(float64List(types.Float64Value(0.5), types.Float64Unknown()), validator))
+	assert.False(t, isValidList(float64List(types.Float64Value(0.5), types.Float64Value(1)), validator))
+	assert.False(t, isValidList(float64List(types.Float64Value(0)), validator))
+	assert.False(t, isValidList(float64List(types.Float64Value(0.5), types.Float64Value(0.5)), validator))
+
+	assert.True(t, isValidList(types.ListNull(types.Float64Type), validator))
+}
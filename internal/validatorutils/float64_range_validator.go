@@ -0,0 +1,56 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type float64ExclusiveRangeValidator struct {
+	min float64
+	max float64
+}
+
+// NewFloat64ExclusiveRangeValidator validates that a number is greater than
+// min and less than max.
+func NewFloat64ExclusiveRangeValidator(min, max float64) validator.Float64 {
+	return &float64ExclusiveRangeValidator{min: min, max: max}
+}
+
+// NewFloat64GreaterThanValidator validates that a number is greater than min.
+func NewFloat64GreaterThanValidator(min float64) validator.Float64 {
+	return &float64ExclusiveRangeValidator{min: min, max: math.Inf(1)}
+}
+
+var _ validator.Float64 = (*float64ExclusiveRangeValidator)(nil)
+
+func (v float64ExclusiveRangeValidator) Description(ctx context.Context) string {
+	if math.IsInf(v.max, 1) {
+		return fmt.Sprintf("Validates that the value is greater than %v", v.min)
+	}
+	return fmt.Sprintf("Validates that the value is greater than %v and less than %v", v.min, v.max)
+}
+
+func (v float64ExclusiveRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64ExclusiveRangeValidator) ValidateFloat64(ctx context.Context, request validator.Float64Request, response *validator.Float64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueFloat64()
+	if value <= v.min || value >= v.max {
+		expected := fmt.Sprintf("greater than %v and less than %v", v.min, v.max)
+		if math.IsInf(v.max, 1) {
+			expected = fmt.Sprintf("greater than %v", v.min)
+		}
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid value",
+			fmt.Sprintf("The value %v must be %s.", value, expected))
+	}
+}
@@ -0,0 +1,45 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func isValidFloat64(value types.Float64, vldtr validator.Float64) bool {
+	var request validator.Float64Request
+	request.ConfigValue = value
+	var response validator.Float64Response
+
+	vldtr.ValidateFloat64(context.TODO(), request, &response)
+	return !response.Diagnostics.HasError()
+}
+
+func TestFloat64ExclusiveRangeValidator(t *testing.T) {
+	validator := NewFloat64ExclusiveRangeValidator(0, 100)
+
+	assert.True(t, isValidFloat64(types.Float64Value(0.5), validator))
+	assert.True(t, isValidFloat64(types.Float64Value(10), validator))
+	assert.True(t, isValidFloat64(types.Float64Value(99.9), validator))
+	assert.False(t, isValidFloat64(types.Float64Value(0), validator))
+	assert.False(t, isValidFloat64(types.Float64Value(100), validator))
+	assert.False(t, isValidFloat64(types.Float64Value(-1), validator))
+
+	assert.True(t, isValidFloat64(types.Float64Null(), validator))
+	assert.True(t, isValidFloat64(types.Float64Unknown(), validator))
+}
+
+func TestFloat64GreaterThanValidator(t *testing.T) {
+	validator := NewFloat64GreaterThanValidator(1)
+
+	assert.True(t, isValidFloat64(types.Float64Value(1.5), validator))
+	assert.True(t, isValidFloat64(types.Float64Value(1e9), validator))
+	assert.False(t, isValidFloat64(types.Float64Value(1), validator))
+	assert.False(t, isValidFloat64(types.Float64Value(0), validator))
+
+	assert.True(t, isValidFloat64(types.Float64Null(), validator))
+	assert.True(t, isValidFloat64(types.Float64Unknown(), validator))
+}
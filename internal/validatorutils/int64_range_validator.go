@@ -0,0 +1,56 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type int64RangeValidator struct {
+	min int64
+	max int64
+}
+
+// NewInt64RangeValidator validates that an integer is between min and max,
+// inclusive.
+func NewInt64RangeValidator(min, max int64) validator.Int64 {
+	return &int64RangeValidator{min: min, max: max}
+}
+
+// NewInt64AtLeastValidator validates that an integer is at least min.
+func NewInt64AtLeastValidator(min int64) validator.Int64 {
+	return &int64RangeValidator{min: min, max: math.MaxInt64}
+}
+
+var _ validator.Int64 = (*int64RangeValidator)(nil)
+
+func (v int64RangeValidator) Description(ctx context.Context) string {
+	if v.max == math.MaxInt64 {
+		return fmt.Sprintf("Validates that the value is at least %d", v.min)
+	}
+	return fmt.Sprintf("Validates that the value is between %d and %d", v.min, v.max)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, request validator.Int64Request, response *validator.Int64Response) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		expected := fmt.Sprintf("between %d and %d", v.min, v.max)
+		if v.max == math.MaxInt64 {
+			expected = fmt.Sprintf("at least %d", v.min)
+		}
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid value",
+			fmt.Sprintf("The value %d must be %s.", value, expected))
+	}
+}
@@ -0,0 +1,43 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func isValidInt64(value types.Int64, vldtr validator.Int64) bool {
+	var request validator.Int64Request
+	request.ConfigValue = value
+	var response validator.Int64Response
+
+	vldtr.ValidateInt64(context.TODO(), request, &response)
+	return !response.Diagnostics.HasError()
+}
+
+func TestInt64RangeValidator(t *testing.T) {
+	validator := NewInt64RangeValidator(1, 65535)
+
+	assert.True(t, isValidInt64(types.Int64Value(1), validator))
+	assert.True(t, isValidInt64(types.Int64Value(443), validator))
+	assert.True(t, isValidInt64(types.Int64Value(65535), validator))
+	assert.False(t, isValidInt64(types.Int64Value(0), validator))
+	assert.False(t, isValidInt64(types.Int64Value(65536), validator))
+	assert.False(t, isValidInt64(types.Int64Value(-1), validator))
+
+	assert.True(t, isValidInt64(types.Int64Null(), validator))
+	assert.True(t, isValidInt64(types.Int64Unknown(), validator))
+}
+
+func TestInt64AtLeastValidator(t *testing.T) {
+	validator := NewInt64AtLeastValidator(0)
+
+	assert.True(t, isValidInt64(types.Int64Value(0), validator))
+	assert.True(t, isValidInt64(types.Int64Value(30), validator))
+	assert.False(t, isValidInt64(types.Int64Value(-1), validator))
+
+	assert.True(t, isValidInt64(types.Int64Null(), validator))
+}
@@ -0,0 +1,47 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type jsonObjectValidator struct {
+}
+
+var _ validator.String = (*jsonObjectValidator)(nil)
+
+// NewJSONObjectValidator returns a validator that checks that the string is a
+// JSON object, e.g. as produced by jsonencode().
+func NewJSONObjectValidator() validator.String {
+	return &jsonObjectValidator{}
+}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "Validates that the string is a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]any
+	if err := jsoniter.UnmarshalFromString(request.ConfigValue.ValueString(), &object); err != nil || object == nil {
+		detail := "The value must be a JSON object."
+		if err != nil {
+			detail = fmt.Sprintf("The value must be a JSON object: %v", err)
+		}
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid JSON object",
+			detail,
+		)
+	}
+}
@@ -0,0 +1,20 @@
+package validatorutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestNewJSONObjectValidator(t *testing.T) {
+	validator := NewJSONObjectValidator()
+	assert.True(t, IsValidForValidator(types.StringValue(`{}`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`{"name": "test", "ids": [1, 2]}`), validator))
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+	assert.True(t, IsValidForValidator(types.StringUnknown(), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`{"name": }`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`[1, 2]`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`null`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`"name"`), validator))
+}
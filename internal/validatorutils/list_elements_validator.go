@@ -0,0 +1,50 @@
+package validatorutils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listElementsValidator struct {
+	element validator.String
+}
+
+// NewListElementsValidator validates each element of a list of strings with
+// element.
+func NewListElementsValidator(element validator.String) validator.List {
+	return &listElementsValidator{element: element}
+}
+
+var _ validator.List = (*listElementsValidator)(nil)
+
+func (v listElementsValidator) Description(ctx context.Context) string {
+	return "Validates each element of the list: " + v.element.Description(ctx)
+}
+
+func (v listElementsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v listElementsValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range request.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok {
+			continue
+		}
+		elementRequest := validator.StringRequest{
+			Path:           request.Path.AtListIndex(i),
+			PathExpression: request.PathExpression.AtListIndex(i),
+			ConfigValue:    value,
+			Config:         request.Config,
+		}
+		elementResponse := &validator.StringResponse{}
+		v.element.ValidateString(ctx, elementRequest, elementResponse)
+		response.Diagnostics.Append(elementResponse.Diagnostics...)
+	}
+}
@@ -0,0 +1,24 @@
+package validatorutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestListElementsValidator(t *testing.T) {
+	validator := NewListElementsValidator(NewLabelNameValidator())
+
+	valid := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pod"), types.StringValue("__name__")})
+	invalid := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pod"), types.StringValue("pod-name")})
+	withUnknown := types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()})
+
+	assert.True(t, isValidList(valid, validator))
+	assert.False(t, isValidList(invalid, validator))
+	assert.True(t, isValidList(withUnknown, validator))
+
+	assert.True(t, isValidList(types.ListNull(types.StringType), validator))
+	assert.True(t, isValidList(types.ListUnknown(types.StringType), validator))
+}
@@ -0,0 +1,41 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type listLengthAtLeastValidator struct {
+	min int
+}
+
+// NewListLengthAtLeastValidator validates that a list has at least min
+// elements.
+func NewListLengthAtLeastValidator(min int) validator.List {
+	return &listLengthAtLeastValidator{min: min}
+}
+
+var _ validator.List = (*listLengthAtLeastValidator)(nil)
+
+func (v listLengthAtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Validates that the list has at least %d elements", v.min)
+}
+
+func (v listLengthAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v listLengthAtLeastValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if length := len(request.ConfigValue.Elements()); length < v.min {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid list length",
+			fmt.Sprintf("The list must have at least %d elements, got %d.", v.min, length))
+	}
+}
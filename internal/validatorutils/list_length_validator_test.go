@@ -0,0 +1,35 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func isValidList(value types.List, vldtr validator.List) bool {
+	var request validator.ListRequest
+	request.ConfigValue = value
+	var response validator.ListResponse
+
+	vldtr.ValidateList(context.TODO(), request, &response)
+	return !response.Diagnostics.HasError()
+}
+
+func TestListLengthAtLeastValidator(t *testing.T) {
+	validator := NewListLengthAtLeastValidator(1)
+
+	one := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})
+	two := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+
+	assert.True(t, isValidList(one, validator))
+	assert.True(t, isValidList(two, validator))
+	assert.False(t, isValidList(empty, validator))
+
+	assert.True(t, isValidList(types.ListNull(types.StringType), validator))
+	assert.True(t, isValidList(types.ListUnknown(types.StringType), validator))
+}
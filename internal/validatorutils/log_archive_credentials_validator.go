@@ -0,0 +1,79 @@
+package validatorutils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// logArchiveCredentialsValidator validates that the S3 destination of a log
+// archive has exactly one kind of credentials: role_arn, or access_key_id
+// together with secret_access_key. Unknown values count as set.
+type logArchiveCredentialsValidator struct{}
+
+var _ resource.ConfigValidator = (*logArchiveCredentialsValidator)(nil)
+
+func NewLogArchiveCredentialsValidator() resource.ConfigValidator {
+	return &logArchiveCredentialsValidator{}
+}
+
+func (v logArchiveCredentialsValidator) Description(ctx context.Context) string {
+	return "Validates that s3 sets either role_arn or access_key_id together with secret_access_key."
+}
+
+func (v logArchiveCredentialsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v logArchiveCredentialsValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	s3Path := path.Root("s3")
+	var s3 types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, s3Path, &s3)...)
+	if resp.Diagnostics.HasError() || s3.IsNull() || s3.IsUnknown() {
+		return
+	}
+
+	var roleArn, accessKeyID, secretAccessKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, s3Path.AtName("role_arn"), &roleArn)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, s3Path.AtName("access_key_id"), &accessKeyID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, s3Path.AtName("secret_access_key"), &secretAccessKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hasRole := !roleArn.IsNull()
+	hasKeyID := !accessKeyID.IsNull()
+	hasSecret := !secretAccessKey.IsNull()
+
+	switch {
+	case hasKeyID && !hasSecret:
+		resp.Diagnostics.AddAttributeError(
+			s3Path.AtName("secret_access_key"),
+			"Missing secret access key",
+			"access_key_id and secret_access_key must be set together.",
+		)
+	case hasSecret && !hasKeyID:
+		resp.Diagnostics.AddAttributeError(
+			s3Path.AtName("access_key_id"),
+			"Missing access key ID",
+			"access_key_id and secret_access_key must be set together.",
+		)
+	case hasRole && hasKeyID:
+		resp.Diagnostics.AddAttributeError(
+			s3Path.AtName("role_arn"),
+			"Conflicting credentials",
+			"Only one of role_arn or access_key_id and secret_access_key can be set.",
+		)
+	case !hasRole && !hasKeyID:
+		resp.Diagnostics.AddAttributeError(
+			s3Path,
+			"Missing credentials",
+			"One of role_arn or access_key_id and secret_access_key must be set.",
+		)
+	}
+}
@@ -0,0 +1,99 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestLogArchiveCredentialsValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"s3": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"role_arn":          schema.StringAttribute{Optional: true},
+					"access_key_id":     schema.StringAttribute{Optional: true},
+					"secret_access_key": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+	s3Type := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"role_arn":          tftypes.String,
+		"access_key_id":     tftypes.String,
+		"secret_access_key": tftypes.String,
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"s3": s3Type}}
+
+	s3Path := path.Root("s3")
+	tests := []struct {
+		name            string
+		roleArn         any
+		accessKeyID     any
+		secretAccessKey any
+		wantError       bool
+		wantPath        path.Path
+	}{
+		{name: "role", roleArn: "arn:aws:iam::123456789012:role/oodle"},
+		{name: "access keys", accessKeyID: "id", secretAccessKey: tftypes.UnknownValue},
+		{name: "no credentials", wantError: true, wantPath: s3Path},
+		{
+			name:        "access key without secret",
+			accessKeyID: "id",
+			wantError:   true,
+			wantPath:    s3Path.AtName("secret_access_key"),
+		},
+		{
+			name:            "secret without access key",
+			secretAccessKey: "secret",
+			wantError:       true,
+			wantPath:        s3Path.AtName("access_key_id"),
+		},
+		{
+			name:            "role and access keys",
+			roleArn:         "arn:aws:iam::123456789012:role/oodle",
+			accessKeyID:     "id",
+			secretAccessKey: "secret",
+			wantError:       true,
+			wantPath:        s3Path.AtName("role_arn"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"s3": tftypes.NewValue(s3Type, map[string]tftypes.Value{
+							"role_arn":          tftypes.NewValue(tftypes.String, tt.roleArn),
+							"access_key_id":     tftypes.NewValue(tftypes.String, tt.accessKeyID),
+							"secret_access_key": tftypes.NewValue(tftypes.String, tt.secretAccessKey),
+						}),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewLogArchiveCredentialsValidator().ValidateResource(ctx, req, resp)
+			if !tt.wantError {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), 1)
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			assert.True(t, ok)
+			assert.True(t, withPath.Path().Equal(tt.wantPath))
+		})
+	}
+}
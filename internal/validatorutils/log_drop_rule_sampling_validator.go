@@ -0,0 +1,69 @@
+package validatorutils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// logDropRuleSamplingValidator validates that sample_percentage is set when
+// a log drop rule samples, and that the sampling options are only set then.
+type logDropRuleSamplingValidator struct{}
+
+var _ resource.ConfigValidator = (*logDropRuleSamplingValidator)(nil)
+
+func NewLogDropRuleSamplingValidator() resource.ConfigValidator {
+	return &logDropRuleSamplingValidator{}
+}
+
+func (v logDropRuleSamplingValidator) Description(ctx context.Context) string {
+	return "Validates that sample_percentage is set when action is 'sample' and that the sampling options are only set then."
+}
+
+func (v logDropRuleSamplingValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v logDropRuleSamplingValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var action types.String
+	var samplePercentage types.Float64
+	var sampleKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &action)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sample_percentage"), &samplePercentage)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sample_key"), &sampleKey)...)
+	if resp.Diagnostics.HasError() || action.IsNull() || action.IsUnknown() {
+		return
+	}
+
+	switch action.ValueString() {
+	case "drop":
+		if !samplePercentage.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sample_percentage"),
+				"Invalid attribute combination",
+				"sample_percentage can only be set when action is 'sample'.",
+			)
+		}
+		if !sampleKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sample_key"),
+				"Invalid attribute combination",
+				"sample_key can only be set when action is 'sample'.",
+			)
+		}
+	case "sample":
+		if samplePercentage.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sample_percentage"),
+				"Missing sample percentage",
+				"sample_percentage is required when action is 'sample'.",
+			)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestLogDropRuleSamplingValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action":            schema.StringAttribute{Required: true},
+			"sample_percentage": schema.Float64Attribute{Optional: true},
+			"sample_key":        schema.StringAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"action":            tftypes.String,
+		"sample_percentage": tftypes.Number,
+		"sample_key":        tftypes.String,
+	}}
+
+	tests := []struct {
+		name             string
+		action           any
+		samplePercentage any
+		sampleKey        any
+		wantPaths        []path.Path
+	}{
+		{name: "drop", action: "drop"},
+		{name: "sample", action: "sample", samplePercentage: 10.0, sampleKey: "trace_id"},
+		{name: "unknown percentage", action: "sample", samplePercentage: tftypes.UnknownValue},
+		{name: "unknown action", action: tftypes.UnknownValue, sampleKey: "trace_id"},
+		{
+			name:             "drop with sampling options",
+			action:           "drop",
+			samplePercentage: 10.0,
+			sampleKey:        "trace_id",
+			wantPaths:        []path.Path{path.Root("sample_percentage"), path.Root("sample_key")},
+		},
+		{
+			name:      "sample without percentage",
+			action:    "sample",
+			sampleKey: "trace_id",
+			wantPaths: []path.Path{path.Root("sample_percentage")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"action":            tftypes.NewValue(tftypes.String, tt.action),
+						"sample_percentage": tftypes.NewValue(tftypes.Number, tt.samplePercentage),
+						"sample_key":        tftypes.NewValue(tftypes.String, tt.sampleKey),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewLogDropRuleSamplingValidator().ValidateResource(ctx, req, resp)
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), len(tt.wantPaths))
+			for i, err := range resp.Diagnostics.Errors() {
+				withPath, ok := err.(diag.DiagnosticWithPath)
+				assert.True(t, ok)
+				assert.True(t, withPath.Path().Equal(tt.wantPaths[i]))
+			}
+		})
+	}
+}
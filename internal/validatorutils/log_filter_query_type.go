@@ -0,0 +1,134 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-oodle/internal/logfilter"
+)
+
+// Compile-time interface checks.
+var (
+	_ basetypes.StringTypable                    = LogFilterQueryType{}
+	_ basetypes.StringValuableWithSemanticEquals = LogFilterQueryValue{}
+)
+
+// LogFilterQueryType is a custom Terraform Framework type that represents a
+// log filter query string. It implements basetypes.StringTypable.
+type LogFilterQueryType struct {
+	basetypes.StringType
+}
+
+// NewLogFilterQueryType returns a new LogFilterQueryType.
+func NewLogFilterQueryType() LogFilterQueryType {
+	return LogFilterQueryType{}
+}
+
+// Equal returns true if the given type is a LogFilterQueryType.
+func (t LogFilterQueryType) Equal(o attr.Type) bool {
+	_, ok := o.(LogFilterQueryType)
+	return ok
+}
+
+// String returns a human-readable string of the type name.
+func (t LogFilterQueryType) String() string {
+	return "LogFilterQueryType"
+}
+
+// ValueFromString wraps a StringValue in a LogFilterQueryValue.
+func (t LogFilterQueryType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return LogFilterQueryValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a tftypes.Value into a LogFilterQueryValue.
+func (t LogFilterQueryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns the value type of this type.
+func (t LogFilterQueryType) ValueType(ctx context.Context) attr.Value {
+	return LogFilterQueryValue{}
+}
+
+// LogFilterQueryValue is a custom Terraform Framework value that represents a
+// log filter query string. It implements
+// basetypes.StringValuableWithSemanticEquals so that queries describing the
+// same filter are equal, e.g. "level:(error OR fatal)" and
+// "level:error OR level:fatal".
+type LogFilterQueryValue struct {
+	basetypes.StringValue
+}
+
+// NewLogFilterQueryValue returns a new LogFilterQueryValue with the given string.
+func NewLogFilterQueryValue(s string) LogFilterQueryValue {
+	return LogFilterQueryValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// NewLogFilterQueryNull returns a new null LogFilterQueryValue.
+func NewLogFilterQueryNull() LogFilterQueryValue {
+	return LogFilterQueryValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Equal returns true if the given value is a LogFilterQueryValue with the same
+// underlying string.
+func (v LogFilterQueryValue) Equal(o attr.Value) bool {
+	other, ok := o.(LogFilterQueryValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the type of this value.
+func (v LogFilterQueryValue) Type(ctx context.Context) attr.Type {
+	return LogFilterQueryType{}
+}
+
+// ToStringValue returns the underlying StringValue.
+func (v LogFilterQueryValue) ToStringValue(ctx context.Context) (basetypes.StringValue, diag.Diagnostics) {
+	return v.StringValue, nil
+}
+
+// StringSemanticEquals compares two queries by their canonical form.
+//
+// As with DurationValue, unparseable queries are never semantically equal and
+// no diagnostics are returned; parse errors are reported by the
+// LogFilterQueryValidator instead.
+func (v LogFilterQueryValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newStringValue, diags := newValuable.ToStringValue(ctx)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	priorQuery, err := logfilter.Canonicalize(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	newQuery, err := logfilter.Canonicalize(newStringValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	return priorQuery == newQuery, nil
+}
@@ -0,0 +1,55 @@
+package validatorutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/logfilter"
+)
+
+type logFilterQueryValidator struct{}
+
+var _ validator.String = (*logFilterQueryValidator)(nil)
+
+// NewLogFilterQueryValidator returns a string validator that fails when the
+// input is not a valid log filter query.
+func NewLogFilterQueryValidator() validator.String {
+	return &logFilterQueryValidator{}
+}
+
+func (v logFilterQueryValidator) Description(_ context.Context) string {
+	return "Validates that the string is a valid log filter query"
+}
+
+func (v logFilterQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v logFilterQueryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	filter, err := logfilter.Parse(req.ConfigValue.ValueString())
+	if err == nil {
+		// Every parsed filter must also have a canonical form for Read.
+		_, err = logfilter.Format(filter)
+	}
+	if err == nil {
+		return
+	}
+
+	detail := err.Error()
+	var parseErr *logfilter.ParseError
+	if errors.As(err, &parseErr) {
+		detail = fmt.Sprintf("%v:\n\n%v", err, parseErr.Snippet())
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid filter query",
+		detail,
+	)
+}
@@ -0,0 +1,73 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestLogFilterQueryValidator(t *testing.T) {
+	validator := NewLogFilterQueryValidator()
+
+	assert.True(t, IsValidForValidator(types.StringValue("service:checkout"), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`level:(error OR fatal) AND NOT msg:"healthcheck"`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue("payload[$.user.id]:* OR msg:/time(out|d out)/"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(""), validator))
+	assert.False(t, IsValidForValidator(types.StringValue("service"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue("level:(error OR"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`msg:"unterminated`), validator))
+
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+	assert.True(t, IsValidForValidator(types.StringUnknown(), validator))
+}
+
+func TestLogFilterQuerySemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		valueA   string
+		valueB   string
+		expected bool
+	}{
+		{
+			valueA:   "level:(error OR fatal)",
+			valueB:   "level:error OR level:fatal",
+			expected: true,
+		},
+		{
+			valueA:   "a:1 b:2",
+			valueB:   "a:1 AND b:2",
+			expected: true,
+		},
+		{
+			valueA:   `msg:"healthcheck"`,
+			valueB:   "msg:healthcheck",
+			expected: true,
+		},
+		{
+			valueA:   "a:1 AND b:2",
+			valueB:   "b:2 AND a:1",
+			expected: false,
+		},
+		{
+			valueA:   "a:1 b:2 OR c:3",
+			valueB:   "a:1 AND (b:2 OR c:3)",
+			expected: false,
+		},
+		{
+			valueA:   "a:(1",
+			valueB:   "a:(1",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.valueA+" vs "+tt.valueB, func(t *testing.T) {
+			equal, diags := NewLogFilterQueryValue(tt.valueA).StringSemanticEquals(ctx, NewLogFilterQueryValue(tt.valueB))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, equal, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// logMetricsDefinitionsValidator validates that the options of each metric
+// definition of a log metrics rule fit its type: buckets only on histograms,
+// quantiles only on summaries and no temporality on gauges.
+type logMetricsDefinitionsValidator struct{}
+
+var _ resource.ConfigValidator = (*logMetricsDefinitionsValidator)(nil)
+
+func NewLogMetricsDefinitionsValidator() resource.ConfigValidator {
+	return &logMetricsDefinitionsValidator{}
+}
+
+func (v logMetricsDefinitionsValidator) Description(ctx context.Context) string {
+	return "Validates that the options of each metric definition fit its type."
+}
+
+func (v logMetricsDefinitionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v logMetricsDefinitionsValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var definitions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric_definitions"), &definitions)...)
+	if resp.Diagnostics.HasError() || definitions.IsNull() || definitions.IsUnknown() {
+		return
+	}
+
+	for i, element := range definitions.Elements() {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		v.validateDefinition(ctx, req, resp, path.Root("metric_definitions").AtListIndex(i))
+	}
+}
+
+func (v logMetricsDefinitionsValidator) validateDefinition(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+	definitionPath path.Path,
+) {
+	var metricType, temporality types.String
+	var buckets types.Object
+	var quantiles types.List
+	// Earlier definitions may have added errors already, so the errors of
+	// reading this one are collected separately.
+	var diags diag.Diagnostics
+	diags.Append(req.Config.GetAttribute(ctx, definitionPath.AtName("type"), &metricType)...)
+	diags.Append(req.Config.GetAttribute(ctx, definitionPath.AtName("temporality"), &temporality)...)
+	diags.Append(req.Config.GetAttribute(ctx, definitionPath.AtName("buckets"), &buckets)...)
+	diags.Append(req.Config.GetAttribute(ctx, definitionPath.AtName("quantiles"), &quantiles)...)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || metricType.IsNull() || metricType.IsUnknown() {
+		return
+	}
+	definitionType := clientmodels.MetricType(metricType.ValueString())
+
+	if !buckets.IsNull() {
+		if definitionType != clientmodels.HistogramMetricDefinition {
+			resp.Diagnostics.AddAttributeError(
+				definitionPath.AtName("buckets"),
+				"Invalid attribute combination",
+				fmt.Sprintf("buckets can only be set on histogram metrics, not %v.", definitionType),
+			)
+		} else if !buckets.IsUnknown() {
+			v.validateBuckets(ctx, req, resp, definitionPath.AtName("buckets"))
+		}
+	}
+
+	switch {
+	case !quantiles.IsNull() && definitionType != clientmodels.SummaryMetricDefinition:
+		resp.Diagnostics.AddAttributeError(
+			definitionPath.AtName("quantiles"),
+			"Invalid attribute combination",
+			fmt.Sprintf("quantiles can only be set on summary metrics, not %v.", definitionType),
+		)
+	case definitionType == clientmodels.SummaryMetricDefinition && !quantiles.IsUnknown() && len(quantiles.Elements()) == 0:
+		resp.Diagnostics.AddAttributeError(
+			definitionPath.AtName("quantiles"),
+			"Missing quantiles",
+			"quantiles are required for summary metrics.",
+		)
+	}
+
+	if !temporality.IsNull() && definitionType == clientmodels.GaugeMetricDefinition {
+		resp.Diagnostics.AddAttributeError(
+			definitionPath.AtName("temporality"),
+			"Invalid attribute combination",
+			"temporality cannot be set on gauge metrics.",
+		)
+	}
+}
+
+// validateBuckets checks that exactly one bucket generator is set.
+func (v logMetricsDefinitionsValidator) validateBuckets(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+	bucketsPath path.Path,
+) {
+	var explicit types.List
+	var exponential, linear types.Object
+	var diags diag.Diagnostics
+	diags.Append(req.Config.GetAttribute(ctx, bucketsPath.AtName("explicit"), &explicit)...)
+	diags.Append(req.Config.GetAttribute(ctx, bucketsPath.AtName("exponential"), &exponential)...)
+	diags.Append(req.Config.GetAttribute(ctx, bucketsPath.AtName("linear"), &linear)...)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	set := 0
+	for _, isSet := range []bool{!explicit.IsNull(), !exponential.IsNull(), !linear.IsNull()} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddAttributeError(
+			bucketsPath,
+			"Invalid buckets",
+			"Exactly one of explicit, exponential or linear must be set.",
+		)
+	}
+}
@@ -0,0 +1,194 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestLogMetricsDefinitionsValidator(t *testing.T) {
+	ctx := context.Background()
+
+	// The bucket generators are reduced to a single attribute, which is all
+	// the validator looks at.
+	generatorAttrs := map[string]schema.Attribute{"x": schema.Float64Attribute{Optional: true}}
+	generatorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"x": tftypes.Number}}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"metric_definitions": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type":        schema.StringAttribute{Required: true},
+						"temporality": schema.StringAttribute{Optional: true},
+						"quantiles":   schema.ListAttribute{Optional: true, ElementType: types.Float64Type},
+						"buckets": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"explicit":    schema.ListAttribute{Optional: true, ElementType: types.Float64Type},
+								"exponential": schema.SingleNestedAttribute{Optional: true, Attributes: generatorAttrs},
+								"linear":      schema.SingleNestedAttribute{Optional: true, Attributes: generatorAttrs},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	float64ListType := tftypes.List{ElementType: tftypes.Number}
+	bucketsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"explicit":    float64ListType,
+		"exponential": generatorType,
+		"linear":      generatorType,
+	}}
+	definitionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":        tftypes.String,
+		"temporality": tftypes.String,
+		"quantiles":   float64ListType,
+		"buckets":     bucketsType,
+	}}
+	definitionsType := tftypes.List{ElementType: definitionType}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"metric_definitions": definitionsType}}
+
+	numbers := func(values ...float64) tftypes.Value {
+		res := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			res[i] = tftypes.NewValue(tftypes.Number, v)
+		}
+		return tftypes.NewValue(float64ListType, res)
+	}
+	generator := tftypes.NewValue(generatorType, map[string]tftypes.Value{"x": tftypes.NewValue(tftypes.Number, 1)})
+	buckets := func(explicit, exponential, linear bool) tftypes.Value {
+		value := map[string]tftypes.Value{
+			"explicit":    tftypes.NewValue(float64ListType, nil),
+			"exponential": tftypes.NewValue(generatorType, nil),
+			"linear":      tftypes.NewValue(generatorType, nil),
+		}
+		if explicit {
+			value["explicit"] = numbers(1, 2, 4)
+		}
+		if exponential {
+			value["exponential"] = generator
+		}
+		if linear {
+			value["linear"] = generator
+		}
+		return tftypes.NewValue(bucketsType, value)
+	}
+	type definition struct {
+		metricType  any
+		temporality any
+		quantiles   *tftypes.Value
+		buckets     *tftypes.Value
+	}
+	explicitBuckets := buckets(true, false, false)
+	twoGenerators := buckets(true, false, true)
+	noGenerator := buckets(false, false, false)
+	quantiles := numbers(0.5, 0.99)
+	noQuantiles := numbers()
+
+	tests := []struct {
+		name        string
+		definitions []definition
+		wantPaths   []path.Path
+	}{
+		{
+			name: "valid",
+			definitions: []definition{
+				{metricType: "histogram", buckets: &explicitBuckets},
+				{metricType: "histogram"},
+				{metricType: "summary", quantiles: &quantiles},
+				{metricType: "counter", temporality: "delta"},
+			},
+		},
+		{
+			name:        "unknown type",
+			definitions: []definition{{metricType: tftypes.UnknownValue, buckets: &explicitBuckets}},
+		},
+		{
+			name: "buckets on counter",
+			definitions: []definition{
+				{metricType: "histogram", buckets: &explicitBuckets},
+				{metricType: "counter", buckets: &explicitBuckets},
+			},
+			wantPaths: []path.Path{path.Root("metric_definitions").AtListIndex(1).AtName("buckets")},
+		},
+		{
+			name:        "two bucket generators",
+			definitions: []definition{{metricType: "histogram", buckets: &twoGenerators}},
+			wantPaths:   []path.Path{path.Root("metric_definitions").AtListIndex(0).AtName("buckets")},
+		},
+		{
+			name:        "no bucket generator",
+			definitions: []definition{{metricType: "histogram", buckets: &noGenerator}},
+			wantPaths:   []path.Path{path.Root("metric_definitions").AtListIndex(0).AtName("buckets")},
+		},
+		{
+			name: "summary without quantiles",
+			definitions: []definition{
+				{metricType: "summary"},
+				{metricType: "summary", quantiles: &noQuantiles},
+			},
+			wantPaths: []path.Path{
+				path.Root("metric_definitions").AtListIndex(0).AtName("quantiles"),
+				path.Root("metric_definitions").AtListIndex(1).AtName("quantiles"),
+			},
+		},
+		{
+			name:        "quantiles on histogram",
+			definitions: []definition{{metricType: "histogram", quantiles: &quantiles}},
+			wantPaths:   []path.Path{path.Root("metric_definitions").AtListIndex(0).AtName("quantiles")},
+		},
+		{
+			name:        "temporality on gauge",
+			definitions: []definition{{metricType: "gauge", temporality: "delta"}},
+			wantPaths:   []path.Path{path.Root("metric_definitions").AtListIndex(0).AtName("temporality")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definitions := make([]tftypes.Value, len(tt.definitions))
+			for i, def := range tt.definitions {
+				value := map[string]tftypes.Value{
+					"type":        tftypes.NewValue(tftypes.String, def.metricType),
+					"temporality": tftypes.NewValue(tftypes.String, def.temporality),
+					"quantiles":   tftypes.NewValue(float64ListType, nil),
+					"buckets":     tftypes.NewValue(bucketsType, nil),
+				}
+				if def.quantiles != nil {
+					value["quantiles"] = *def.quantiles
+				}
+				if def.buckets != nil {
+					value["buckets"] = *def.buckets
+				}
+				definitions[i] = tftypes.NewValue(definitionType, value)
+			}
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"metric_definitions": tftypes.NewValue(definitionsType, definitions),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewLogMetricsDefinitionsValidator().ValidateResource(ctx, req, resp)
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), len(tt.wantPaths))
+			for i, err := range resp.Diagnostics.Errors() {
+				withPath, ok := err.(diag.DiagnosticWithPath)
+				assert.True(t, ok)
+				assert.True(t, withPath.Path().Equal(tt.wantPaths[i]))
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package validatorutils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// logPipelineProcessorsValidator validates that each processor of a log
+// pipeline sets exactly one processor type.
+type logPipelineProcessorsValidator struct{}
+
+var _ resource.ConfigValidator = (*logPipelineProcessorsValidator)(nil)
+
+// processorTypeAttrs lists the processor type attributes in the order they
+// are mentioned in diagnostics.
+var processorTypeAttrs = []string{
+	"grok_parser", "regex_parser", "json_parser", "rename_field", "remove_field", "add_attribute", "redact",
+}
+
+func NewLogPipelineProcessorsValidator() resource.ConfigValidator {
+	return &logPipelineProcessorsValidator{}
+}
+
+func (v logPipelineProcessorsValidator) Description(ctx context.Context) string {
+	return "Validates that each processor sets exactly one processor type."
+}
+
+func (v logPipelineProcessorsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v logPipelineProcessorsValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var processors types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("processors"), &processors)...)
+	if resp.Diagnostics.HasError() || processors.IsNull() || processors.IsUnknown() {
+		return
+	}
+
+	for i, element := range processors.Elements() {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+		processorPath := path.Root("processors").AtListIndex(i)
+
+		// Earlier processors may have added errors already, so the errors of
+		// reading this one are collected separately.
+		var diags diag.Diagnostics
+		set := 0
+		for _, attr := range processorTypeAttrs {
+			var processorType types.Object
+			diags.Append(req.Config.GetAttribute(ctx, processorPath.AtName(attr), &processorType)...)
+			if !processorType.IsNull() {
+				set++
+			}
+		}
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		if set != 1 {
+			resp.Diagnostics.AddAttributeError(
+				processorPath,
+				"Invalid processor",
+				"Exactly one of "+strings.Join(processorTypeAttrs[:len(processorTypeAttrs)-1], ", ")+
+					" or "+processorTypeAttrs[len(processorTypeAttrs)-1]+" must be set.",
+			)
+		}
+	}
+}
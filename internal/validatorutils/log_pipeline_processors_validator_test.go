@@ -0,0 +1,108 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestLogPipelineProcessorsValidator(t *testing.T) {
+	ctx := context.Background()
+
+	// Each processor type is reduced to a single attribute, which is all the
+	// validator looks at.
+	typeAttrs := map[string]schema.Attribute{}
+	typeTypes := map[string]tftypes.Type{}
+	processorType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"x": tftypes.String}}
+	for _, attr := range processorTypeAttrs {
+		typeAttrs[attr] = schema.SingleNestedAttribute{
+			Optional:   true,
+			Attributes: map[string]schema.Attribute{"x": schema.StringAttribute{Optional: true}},
+		}
+		typeTypes[attr] = processorType
+	}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"processors": schema.ListNestedAttribute{
+				Required:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: typeAttrs},
+			},
+		},
+	}
+	elementType := tftypes.Object{AttributeTypes: typeTypes}
+	listType := tftypes.List{ElementType: elementType}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"processors": listType}}
+
+	processor := func(set ...string) tftypes.Value {
+		types := map[string]tftypes.Value{}
+		for _, attr := range processorTypeAttrs {
+			types[attr] = tftypes.NewValue(processorType, nil)
+		}
+		for _, attr := range set {
+			types[attr] = tftypes.NewValue(processorType, map[string]tftypes.Value{
+				"x": tftypes.NewValue(tftypes.String, "value"),
+			})
+		}
+		return tftypes.NewValue(elementType, types)
+	}
+
+	tests := []struct {
+		name       string
+		processors any
+		wantPaths  []path.Path
+	}{
+		{name: "one type each", processors: []tftypes.Value{processor("grok_parser"), processor("redact")}},
+		{name: "unknown processors", processors: tftypes.UnknownValue},
+		{name: "unknown processor", processors: []tftypes.Value{tftypes.NewValue(elementType, tftypes.UnknownValue)}},
+		{
+			name:       "no type",
+			processors: []tftypes.Value{processor("json_parser"), processor()},
+			wantPaths:  []path.Path{path.Root("processors").AtListIndex(1)},
+		},
+		{
+			name:       "no type after an invalid processor",
+			processors: []tftypes.Value{processor(), processor("redact"), processor()},
+			wantPaths: []path.Path{
+				path.Root("processors").AtListIndex(0),
+				path.Root("processors").AtListIndex(2),
+			},
+		},
+		{
+			name:       "two types",
+			processors: []tftypes.Value{processor("json_parser", "remove_field")},
+			wantPaths:  []path.Path{path.Root("processors").AtListIndex(0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"processors": tftypes.NewValue(listType, tt.processors),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewLogPipelineProcessorsValidator().ValidateResource(ctx, req, resp)
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), len(tt.wantPaths))
+			for i, err := range resp.Diagnostics.Errors() {
+				withPath, ok := err.(diag.DiagnosticWithPath)
+				assert.True(t, ok)
+				assert.True(t, withPath.Path().Equal(tt.wantPaths[i]))
+				assert.Equal(t, err.Detail(),
+					"Exactly one of grok_parser, regex_parser, json_parser, rename_field, remove_field, "+
+						"add_attribute or redact must be set.")
+			}
+		})
+	}
+}
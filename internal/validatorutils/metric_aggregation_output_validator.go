@@ -0,0 +1,87 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	amlabels "github.com/prometheus/alertmanager/pkg/labels"
+)
+
+// metricAggregationOutputValidator validates that the output metric of a
+// metric aggregation rule is not selected by its metric_name matcher, as the
+// rule would then aggregate its own output.
+type metricAggregationOutputValidator struct{}
+
+var _ resource.ConfigValidator = (*metricAggregationOutputValidator)(nil)
+
+// aggregationMatchTypes maps the match types of metric_name to their
+// matcher types. Other values are rejected by the schema.
+var aggregationMatchTypes = map[string]amlabels.MatchType{
+	"=":  amlabels.MatchEqual,
+	"!=": amlabels.MatchNotEqual,
+	"=~": amlabels.MatchRegexp,
+	"!~": amlabels.MatchNotRegexp,
+}
+
+func NewMetricAggregationOutputValidator() resource.ConfigValidator {
+	return &metricAggregationOutputValidator{}
+}
+
+func (v metricAggregationOutputValidator) Description(ctx context.Context) string {
+	return "Validates that output_metric_name is not matched by metric_name."
+}
+
+func (v metricAggregationOutputValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v metricAggregationOutputValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	metricNamePath := path.Root("metric_name")
+	var name, matchType, value, outputMetricName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, metricNamePath.AtName("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, metricNamePath.AtName("type"), &matchType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, metricNamePath.AtName("value"), &value)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("output_metric_name"), &outputMetricName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []types.String{name, matchType, value, outputMetricName} {
+		if v.IsNull() || v.IsUnknown() {
+			return
+		}
+	}
+	if name.ValueString() != "__name__" {
+		return
+	}
+	amMatchType, ok := aggregationMatchTypes[matchType.ValueString()]
+	if !ok {
+		return
+	}
+
+	matcher, err := amlabels.NewMatcher(amMatchType, name.ValueString(), value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			metricNamePath.AtName("value"),
+			"Invalid matcher",
+			fmt.Sprintf("Value %q is not a valid matcher pattern: %v", value.ValueString(), err),
+		)
+		return
+	}
+	if matcher.Matches(outputMetricName.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("output_metric_name"),
+			"Output metric matched by the rule",
+			fmt.Sprintf(
+				"output_metric_name %q is matched by metric_name %v, so the rule would aggregate its own output.",
+				outputMetricName.ValueString(), matcher,
+			),
+		)
+	}
+}
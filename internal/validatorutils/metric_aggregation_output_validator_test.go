@@ -0,0 +1,114 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestMetricAggregationOutputValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"metric_name": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"name":  schema.StringAttribute{Required: true},
+					"type":  schema.StringAttribute{Required: true},
+					"value": schema.StringAttribute{Required: true},
+				},
+			},
+			"output_metric_name": schema.StringAttribute{Required: true},
+		},
+	}
+	matcherType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":  tftypes.String,
+		"type":  tftypes.String,
+		"value": tftypes.String,
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"metric_name":        matcherType,
+		"output_metric_name": tftypes.String,
+	}}
+
+	tests := []struct {
+		name       string
+		matchType  string
+		value      string
+		outputName any
+		wantError  string
+	}{
+		{
+			name:       "different name",
+			matchType:  "=",
+			value:      "http_requests_total",
+			outputName: "service:http_requests:sum",
+		},
+		{
+			name:       "same name",
+			matchType:  "=",
+			value:      "http_requests_total",
+			outputName: "http_requests_total",
+			wantError:  `output_metric_name "http_requests_total" is matched by metric_name __name__="http_requests_total", so the rule would aggregate its own output.`,
+		},
+		{
+			name:       "matched by regex",
+			matchType:  "=~",
+			value:      "http_.*",
+			outputName: "http_requests:sum",
+			wantError:  `output_metric_name "http_requests:sum" is matched by metric_name __name__=~"http_.*", so the rule would aggregate its own output.`,
+		},
+		{
+			name:       "excluded by negative regex",
+			matchType:  "!~",
+			value:      ".*:sum",
+			outputName: "http_requests:sum",
+		},
+		{
+			name:       "invalid regex",
+			matchType:  "=~",
+			value:      "http_(",
+			outputName: "http_requests:sum",
+			wantError:  "Value \"http_(\" is not a valid matcher pattern: error parsing regexp: missing closing ): `^(?:http_()$`",
+		},
+		{
+			name:       "unknown output name",
+			matchType:  "=",
+			value:      "http_requests_total",
+			outputName: tftypes.UnknownValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"metric_name": tftypes.NewValue(matcherType, map[string]tftypes.Value{
+							"name":  tftypes.NewValue(tftypes.String, "__name__"),
+							"type":  tftypes.NewValue(tftypes.String, tt.matchType),
+							"value": tftypes.NewValue(tftypes.String, tt.value),
+						}),
+						"output_metric_name": tftypes.NewValue(tftypes.String, tt.outputName),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewMetricAggregationOutputValidator().ValidateResource(ctx, req, resp)
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), 1)
+			assert.Equal(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantError)
+		})
+	}
+}
@@ -3,22 +3,46 @@ package validatorutils
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// metricNamePattern is the Prometheus metric name syntax.
+var metricNamePattern = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// labelNamePattern is the Prometheus label name syntax.
+var labelNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// NewLabelNameValidator returns a validator for Prometheus label names.
+func NewLabelNameValidator() validator.String {
+	return NewRegexValidator(labelNamePattern, fmt.Sprintf("is not a valid label name, label names must match %v", labelNamePattern))
+}
+
 type metricNameValidator struct {
+	prefix string
 }
 
 var _ validator.String = (*metricNameValidator)(nil)
 
+// NewMetricNameValidator returns a validator for the names of metrics
+// generated from logs, which must start with oodle_logs_.
 func NewMetricNameValidator() validator.String {
+	return &metricNameValidator{prefix: "oodle_logs_"}
+}
+
+// NewPrometheusMetricNameValidator returns a validator for metric names that
+// only need to follow the Prometheus metric name syntax.
+func NewPrometheusMetricNameValidator() validator.String {
 	return &metricNameValidator{}
 }
 
 func (m metricNameValidator) Description(ctx context.Context) string {
-	return "Validates that the metric name starts with oodle_logs_"
+	if m.prefix == "" {
+		return "Validates that the metric name is a valid Prometheus metric name"
+	}
+	return fmt.Sprintf("Validates that the metric name starts with %s", m.prefix)
 }
 
 func (m metricNameValidator) MarkdownDescription(ctx context.Context) string {
@@ -35,11 +59,19 @@ func (m metricNameValidator) ValidateString(
 	}
 
 	value := request.ConfigValue.ValueString()
-	if !strings.HasPrefix(value, "oodle_logs_") {
+	if !strings.HasPrefix(value, m.prefix) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid metric name",
+			fmt.Sprintf("Metric name must start with %s, got: %s", m.prefix, value),
+		)
+		return
+	}
+	if m.prefix == "" && !metricNamePattern.MatchString(value) {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
 			"Invalid metric name",
-			fmt.Sprintf("Metric name must start with oodle_logs_, got: %s", value),
+			fmt.Sprintf("Metric name must match %s, got: %s", metricNamePattern, value),
 		)
 	}
 }
@@ -0,0 +1,26 @@
+package validatorutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestNewMetricNameValidator(t *testing.T) {
+	validator := NewMetricNameValidator()
+	assert.True(t, IsValidForValidator(types.StringValue("oodle_logs_requests"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue("requests"), validator))
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+}
+
+func TestNewPrometheusMetricNameValidator(t *testing.T) {
+	validator := NewPrometheusMetricNameValidator()
+	assert.True(t, IsValidForValidator(types.StringValue("http_requests_total"), validator))
+	assert.True(t, IsValidForValidator(types.StringValue("job:http_requests:rate5m"), validator))
+	assert.True(t, IsValidForValidator(types.StringValue("_private"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue("5xx_errors"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue("http-requests"), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(""), validator))
+	assert.True(t, IsValidForValidator(types.StringUnknown(), validator))
+}
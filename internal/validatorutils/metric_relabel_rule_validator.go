@@ -0,0 +1,160 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/oodlehttp/clientmodels"
+)
+
+// relabelTargetPattern is a label name that may reference regex capture
+// groups, as accepted by Prometheus for target_label and labelmap
+// replacements.
+var relabelTargetPattern = regexp.MustCompile(`^(?:(?:[a-zA-Z_]|\$(?:\{\w+\}|\w+))+\w*)+$`)
+
+// Defaults of the relabel fields. Fields set to their default count as unset,
+// as they do for Prometheus.
+const (
+	relabelDefaultSeparator   = ";"
+	relabelDefaultRegex       = "(.*)"
+	relabelDefaultReplacement = "$1"
+)
+
+// metricRelabelRuleValidator validates, like Prometheus does when loading
+// metric_relabel_configs, that each action of a metric relabel rule only gets
+// the fields it uses.
+type metricRelabelRuleValidator struct{}
+
+var _ resource.ConfigValidator = (*metricRelabelRuleValidator)(nil)
+
+func NewMetricRelabelRuleValidator() resource.ConfigValidator {
+	return &metricRelabelRuleValidator{}
+}
+
+func (v metricRelabelRuleValidator) Description(ctx context.Context) string {
+	return "Validates that the relabel fields that are set fit the action."
+}
+
+func (v metricRelabelRuleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v metricRelabelRuleValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var sourceLabels types.List
+	var modulus types.Int64
+	var action, separator, regex, replacement, targetLabel types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_labels"), &sourceLabels)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("separator"), &separator)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("regex"), &regex)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("modulus"), &modulus)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("replacement"), &replacement)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_label"), &targetLabel)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &action)...)
+	if resp.Diagnostics.HasError() || action.IsUnknown() {
+		return
+	}
+	relabelAction := clientmodels.ReplaceRelabelAction
+	if !action.IsNull() {
+		relabelAction = clientmodels.RelabelAction(action.ValueString())
+	}
+
+	if relabelAction == clientmodels.HashModRelabelAction {
+		if modulus.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("modulus"),
+				"Missing modulus",
+				"modulus is required for the hashmod action.",
+			)
+		}
+	} else if !modulus.IsNull() {
+		v.notUsed(resp, "modulus", relabelAction)
+	}
+
+	switch relabelAction {
+	case clientmodels.ReplaceRelabelAction:
+		v.validateTargetLabel(resp, targetLabel, relabelAction, relabelTargetPattern)
+	case clientmodels.HashModRelabelAction:
+		v.validateTargetLabel(resp, targetLabel, relabelAction, labelNamePattern)
+	case clientmodels.LowercaseRelabelAction,
+		clientmodels.UppercaseRelabelAction,
+		clientmodels.KeepEqualRelabelAction,
+		clientmodels.DropEqualRelabelAction:
+		v.validateTargetLabel(resp, targetLabel, relabelAction, labelNamePattern)
+		if differsFromDefault(replacement, relabelDefaultReplacement) {
+			v.notUsed(resp, "replacement", relabelAction)
+		}
+		if (relabelAction == clientmodels.KeepEqualRelabelAction || relabelAction == clientmodels.DropEqualRelabelAction) &&
+			differsFromDefault(regex, relabelDefaultRegex) {
+			v.notUsed(resp, "regex", relabelAction)
+		}
+	case clientmodels.LabelMapRelabelAction:
+		if !replacement.IsNull() && !replacement.IsUnknown() && !relabelTargetPattern.MatchString(replacement.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("replacement"),
+				"Invalid replacement",
+				fmt.Sprintf("replacement %q must be a label name for the labelmap action.", replacement.ValueString()),
+			)
+		}
+	case clientmodels.LabelDropRelabelAction, clientmodels.LabelKeepRelabelAction:
+		if !sourceLabels.IsNull() && !sourceLabels.IsUnknown() && len(sourceLabels.Elements()) > 0 {
+			v.notUsed(resp, "source_labels", relabelAction)
+		}
+		if differsFromDefault(separator, relabelDefaultSeparator) {
+			v.notUsed(resp, "separator", relabelAction)
+		}
+		if differsFromDefault(replacement, relabelDefaultReplacement) {
+			v.notUsed(resp, "replacement", relabelAction)
+		}
+		if !targetLabel.IsNull() {
+			v.notUsed(resp, "target_label", relabelAction)
+		}
+	}
+}
+
+func (v metricRelabelRuleValidator) validateTargetLabel(
+	resp *resource.ValidateConfigResponse,
+	targetLabel types.String,
+	action clientmodels.RelabelAction,
+	pattern *regexp.Regexp,
+) {
+	if targetLabel.IsUnknown() {
+		return
+	}
+	if targetLabel.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_label"),
+			"Missing target label",
+			fmt.Sprintf("target_label is required for the %v action.", action),
+		)
+		return
+	}
+	if !pattern.MatchString(targetLabel.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_label"),
+			"Invalid target label",
+			fmt.Sprintf("target_label %q is not valid for the %v action.", targetLabel.ValueString(), action),
+		)
+	}
+}
+
+func (v metricRelabelRuleValidator) notUsed(resp *resource.ValidateConfigResponse, attr string, action clientmodels.RelabelAction) {
+	resp.Diagnostics.AddAttributeError(
+		path.Root(attr),
+		"Invalid attribute combination",
+		fmt.Sprintf("%s cannot be set for the %v action.", attr, action),
+	)
+}
+
+// differsFromDefault reports whether v is known and differs from def.
+func differsFromDefault(v types.String, def string) bool {
+	return !v.IsNull() && !v.IsUnknown() && v.ValueString() != def
+}
@@ -0,0 +1,205 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestMetricRelabelRuleValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_labels": schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"separator":     schema.StringAttribute{Optional: true},
+			"regex":         schema.StringAttribute{Optional: true},
+			"modulus":       schema.Int64Attribute{Optional: true},
+			"replacement":   schema.StringAttribute{Optional: true},
+			"target_label":  schema.StringAttribute{Optional: true},
+			"action":        schema.StringAttribute{Optional: true},
+		},
+	}
+	sourceLabelsType := tftypes.List{ElementType: tftypes.String}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"source_labels": sourceLabelsType,
+		"separator":     tftypes.String,
+		"regex":         tftypes.String,
+		"modulus":       tftypes.Number,
+		"replacement":   tftypes.String,
+		"target_label":  tftypes.String,
+		"action":        tftypes.String,
+	}}
+
+	tests := []struct {
+		name         string
+		sourceLabels []string
+		separator    any
+		regex        any
+		modulus      any
+		replacement  any
+		targetLabel  any
+		action       any
+		wantError    string
+		wantPath     path.Path
+	}{
+		{
+			name:         "rename metric",
+			sourceLabels: []string{"__name__"},
+			regex:        "http_(.*)",
+			replacement:  "oodle_http_${1}",
+			targetLabel:  "__name__",
+		},
+		{
+			name:        "templated target label",
+			regex:       "(?P<key>[a-z]+)=(.*)",
+			targetLabel: "tag_${key}",
+			action:      "replace",
+		},
+		{
+			name:        "labelmap",
+			regex:       "k8s_(.+)",
+			replacement: "$1",
+			action:      "labelmap",
+		},
+		{
+			name:        "labelkeep with defaults",
+			separator:   ";",
+			regex:       "job|instance",
+			replacement: "$1",
+			action:      "labelkeep",
+		},
+		{
+			name:        "unknown values",
+			regex:       tftypes.UnknownValue,
+			modulus:     tftypes.UnknownValue,
+			targetLabel: tftypes.UnknownValue,
+			action:      "hashmod",
+		},
+		{
+			name:        "unknown action",
+			modulus:     4,
+			targetLabel: "1st",
+			action:      tftypes.UnknownValue,
+		},
+		{
+			name:      "replace without target label",
+			wantError: "target_label is required for the replace action.",
+			wantPath:  path.Root("target_label"),
+		},
+		{
+			name:        "invalid target label",
+			targetLabel: "1st",
+			wantError:   `target_label "1st" is not valid for the replace action.`,
+			wantPath:    path.Root("target_label"),
+		},
+		{
+			name:         "hashmod without modulus",
+			sourceLabels: []string{"pod"},
+			targetLabel:  "shard",
+			action:       "hashmod",
+			wantError:    "modulus is required for the hashmod action.",
+			wantPath:     path.Root("modulus"),
+		},
+		{
+			name:        "hashmod with templated target label",
+			modulus:     4,
+			targetLabel: "shard_$1",
+			action:      "hashmod",
+			wantError:   `target_label "shard_$1" is not valid for the hashmod action.`,
+			wantPath:    path.Root("target_label"),
+		},
+		{
+			name:        "modulus without hashmod",
+			modulus:     4,
+			targetLabel: "shard",
+			wantError:   "modulus cannot be set for the replace action.",
+			wantPath:    path.Root("modulus"),
+		},
+		{
+			name:        "lowercase with replacement",
+			replacement: "x",
+			targetLabel: "env",
+			action:      "lowercase",
+			wantError:   "replacement cannot be set for the lowercase action.",
+			wantPath:    path.Root("replacement"),
+		},
+		{
+			name:        "keepequal with regex",
+			regex:       "prod",
+			targetLabel: "env",
+			action:      "keepequal",
+			wantError:   "regex cannot be set for the keepequal action.",
+			wantPath:    path.Root("regex"),
+		},
+		{
+			name:        "labelmap with invalid replacement",
+			replacement: "k8s-$1",
+			action:      "labelmap",
+			wantError:   `replacement "k8s-$1" must be a label name for the labelmap action.`,
+			wantPath:    path.Root("replacement"),
+		},
+		{
+			name:        "labeldrop with target label",
+			regex:       "build_.*",
+			targetLabel: "build",
+			action:      "labeldrop",
+			wantError:   "target_label cannot be set for the labeldrop action.",
+			wantPath:    path.Root("target_label"),
+		},
+		{
+			name:         "labeldrop with source labels",
+			sourceLabels: []string{"pod"},
+			action:       "labeldrop",
+			wantError:    "source_labels cannot be set for the labeldrop action.",
+			wantPath:     path.Root("source_labels"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sourceLabels []tftypes.Value
+			for _, label := range tt.sourceLabels {
+				sourceLabels = append(sourceLabels, tftypes.NewValue(tftypes.String, label))
+			}
+			sourceLabelsValue := tftypes.NewValue(sourceLabelsType, nil)
+			if sourceLabels != nil {
+				sourceLabelsValue = tftypes.NewValue(sourceLabelsType, sourceLabels)
+			}
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"source_labels": sourceLabelsValue,
+						"separator":     tftypes.NewValue(tftypes.String, tt.separator),
+						"regex":         tftypes.NewValue(tftypes.String, tt.regex),
+						"modulus":       tftypes.NewValue(tftypes.Number, tt.modulus),
+						"replacement":   tftypes.NewValue(tftypes.String, tt.replacement),
+						"target_label":  tftypes.NewValue(tftypes.String, tt.targetLabel),
+						"action":        tftypes.NewValue(tftypes.String, tt.action),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewMetricRelabelRuleValidator().ValidateResource(ctx, req, resp)
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), 1)
+			assert.Equal(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantError)
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			assert.True(t, ok)
+			assert.True(t, withPath.Path().Equal(tt.wantPath))
+		})
+	}
+}
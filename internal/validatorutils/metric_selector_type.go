@@ -0,0 +1,133 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-oodle/internal/metricselector"
+)
+
+// Compile-time interface checks.
+var (
+	_ basetypes.StringTypable                    = MetricSelectorType{}
+	_ basetypes.StringValuableWithSemanticEquals = MetricSelectorValue{}
+)
+
+// MetricSelectorType is a custom Terraform Framework type that represents a
+// metric series selector string. It implements basetypes.StringTypable.
+type MetricSelectorType struct {
+	basetypes.StringType
+}
+
+// NewMetricSelectorType returns a new MetricSelectorType.
+func NewMetricSelectorType() MetricSelectorType {
+	return MetricSelectorType{}
+}
+
+// Equal returns true if the given type is a MetricSelectorType.
+func (t MetricSelectorType) Equal(o attr.Type) bool {
+	_, ok := o.(MetricSelectorType)
+	return ok
+}
+
+// String returns a human-readable string of the type name.
+func (t MetricSelectorType) String() string {
+	return "MetricSelectorType"
+}
+
+// ValueFromString wraps a StringValue in a MetricSelectorValue.
+func (t MetricSelectorType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MetricSelectorValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a tftypes.Value into a MetricSelectorValue.
+func (t MetricSelectorType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns the value type of this type.
+func (t MetricSelectorType) ValueType(ctx context.Context) attr.Value {
+	return MetricSelectorValue{}
+}
+
+// MetricSelectorValue is a custom Terraform Framework value that represents a
+// metric series selector string. It implements
+// basetypes.StringValuableWithSemanticEquals so that selectors matching the
+// same series are equal, e.g. `up{job="api"}` and `{__name__="up",job=api}`.
+type MetricSelectorValue struct {
+	basetypes.StringValue
+}
+
+// NewMetricSelectorValue returns a new MetricSelectorValue with the given string.
+func NewMetricSelectorValue(s string) MetricSelectorValue {
+	return MetricSelectorValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// NewMetricSelectorNull returns a new null MetricSelectorValue.
+func NewMetricSelectorNull() MetricSelectorValue {
+	return MetricSelectorValue{StringValue: basetypes.NewStringNull()}
+}
+
+// Equal returns true if the given value is a MetricSelectorValue with the same
+// underlying string.
+func (v MetricSelectorValue) Equal(o attr.Value) bool {
+	other, ok := o.(MetricSelectorValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// Type returns the type of this value.
+func (v MetricSelectorValue) Type(ctx context.Context) attr.Type {
+	return MetricSelectorType{}
+}
+
+// ToStringValue returns the underlying StringValue.
+func (v MetricSelectorValue) ToStringValue(ctx context.Context) (basetypes.StringValue, diag.Diagnostics) {
+	return v.StringValue, nil
+}
+
+// StringSemanticEquals compares two selectors by their canonical form.
+//
+// As with LogFilterQueryValue, unparseable selectors are never semantically
+// equal and no diagnostics are returned; parse errors are reported by the
+// MetricSelectorValidator instead.
+func (v MetricSelectorValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newStringValue, diags := newValuable.ToStringValue(ctx)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	priorSelector, err := metricselector.Canonicalize(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	newSelector, err := metricselector.Canonicalize(newStringValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	return priorSelector == newSelector, nil
+}
@@ -0,0 +1,41 @@
+package validatorutils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/metricselector"
+)
+
+type metricSelectorValidator struct{}
+
+var _ validator.String = (*metricSelectorValidator)(nil)
+
+// NewMetricSelectorValidator returns a string validator that fails when the
+// input is not a valid metric series selector.
+func NewMetricSelectorValidator() validator.String {
+	return &metricSelectorValidator{}
+}
+
+func (v metricSelectorValidator) Description(_ context.Context) string {
+	return "Validates that the string is a valid metric series selector"
+}
+
+func (v metricSelectorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v metricSelectorValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := metricselector.Parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid selector",
+			err.Error(),
+		)
+	}
+}
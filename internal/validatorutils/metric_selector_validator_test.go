@@ -0,0 +1,68 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestMetricSelectorValidator(t *testing.T) {
+	validator := NewMetricSelectorValidator()
+
+	assert.True(t, IsValidForValidator(types.StringValue("http_requests_total"), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`http_requests_total{env="dev",pod=~"canary-.*"}`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`{__name__=~"go_gc_.*"}`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(""), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`{env="dev"}`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`up{env="dev"`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`up{pod=~"("}`), validator))
+
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+	assert.True(t, IsValidForValidator(types.StringUnknown(), validator))
+}
+
+func TestMetricSelectorSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		valueA   string
+		valueB   string
+		expected bool
+	}{
+		{
+			valueA:   `up{job="api",env="dev"}`,
+			valueB:   `up{job="api", env="dev"}`,
+			expected: true,
+		},
+		{
+			valueA:   `up{job="api"}`,
+			valueB:   `{__name__="up", job=api}`,
+			expected: true,
+		},
+		{
+			valueA:   `up{job="api",env="dev"}`,
+			valueB:   `up{env="dev",job="api"}`,
+			expected: false,
+		},
+		{
+			valueA:   `up{job="api"}`,
+			valueB:   `up{job=~"api"}`,
+			expected: false,
+		},
+		{
+			valueA:   `{job="api"}`,
+			valueB:   `{job="api"}`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.valueA+" vs "+tt.valueB, func(t *testing.T) {
+			equal, diags := NewMetricSelectorValue(tt.valueA).StringSemanticEquals(ctx, NewMetricSelectorValue(tt.valueB))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, equal, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type prometheusRegexValidator struct{}
+
+var _ validator.String = (*prometheusRegexValidator)(nil)
+
+// NewPrometheusRegexValidator returns a string validator that fails when the
+// input is not a regex Prometheus accepts, i.e. a valid RE2 regex once it is
+// anchored at both ends.
+func NewPrometheusRegexValidator() validator.String {
+	return &prometheusRegexValidator{}
+}
+
+func (v prometheusRegexValidator) Description(_ context.Context) string {
+	return "Validates that the string is a valid Prometheus regex"
+}
+
+func (v prometheusRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v prometheusRegexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := regexp.Compile("^(?:" + value + ")$"); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regex",
+			fmt.Sprintf("Value %q is not a valid regex: %v", value, err),
+		)
+	}
+}
@@ -0,0 +1,21 @@
+package validatorutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestPrometheusRegexValidator(t *testing.T) {
+	validator := NewPrometheusRegexValidator()
+
+	assert.True(t, IsValidForValidator(types.StringValue(`http_(.*)`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`(?P<key>[a-z]+)=(.*)`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`job|instance`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`canary-(`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`(?!canary).*`), validator))
+
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+	assert.True(t, IsValidForValidator(types.StringUnknown(), validator))
+}
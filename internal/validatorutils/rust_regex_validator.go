@@ -0,0 +1,88 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-oodle/internal/rustregex"
+)
+
+type rustRegexValidator struct {
+	// extractor requires exactly one capture group, which holds the
+	// extracted value.
+	extractor bool
+
+	// parser requires at least one named capture group, whose names become
+	// the parsed fields.
+	parser bool
+}
+
+var _ validator.String = (*rustRegexValidator)(nil)
+
+// NewRustRegexValidator returns a string validator that fails when the input
+// is not a valid regex in the dialect of the Rust regex crate.
+func NewRustRegexValidator() validator.String {
+	return &rustRegexValidator{}
+}
+
+// NewRustRegexExtractorValidator returns a string validator that fails when the
+// input is not a valid Rust regex with exactly one capture group.
+func NewRustRegexExtractorValidator() validator.String {
+	return &rustRegexValidator{extractor: true}
+}
+
+// NewRustRegexParserValidator returns a string validator that fails when the
+// input is not a valid Rust regex with at least one named capture group.
+func NewRustRegexParserValidator() validator.String {
+	return &rustRegexValidator{parser: true}
+}
+
+func (v rustRegexValidator) Description(_ context.Context) string {
+	if v.extractor {
+		return "Validates that the string is a valid Rust regex with exactly one capture group"
+	}
+	if v.parser {
+		return "Validates that the string is a valid Rust regex with at least one named capture group"
+	}
+	return "Validates that the string is a valid Rust regex"
+}
+
+func (v rustRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rustRegexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	groups, err := rustregex.Check(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regex",
+			fmt.Sprintf("Value %q is not a valid Rust regex: %v", value, err),
+		)
+		return
+	}
+
+	if v.extractor && groups.Count != 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regex",
+			fmt.Sprintf("Regex %q must have exactly one capture group holding the extracted value, found %d. "+
+				"Use (?:...) for groups that should not capture.", value, groups.Count),
+		)
+	}
+
+	if v.parser && len(groups.Names) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regex",
+			fmt.Sprintf("Regex %q must have at least one named capture group, as in (?P<name>...).", value),
+		)
+	}
+}
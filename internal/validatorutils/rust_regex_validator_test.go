@@ -0,0 +1,46 @@
+package validatorutils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestRustRegexValidator(t *testing.T) {
+	validator := NewRustRegexValidator()
+
+	assert.True(t, IsValidForValidator(types.StringValue(`^GET /health`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`(?P<method>GET|POST) (\S+)`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`foo(?=bar)`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`(a)\1`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`(unclosed`), validator))
+
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+	assert.True(t, IsValidForValidator(types.StringUnknown(), validator))
+}
+
+func TestRustRegexExtractorValidator(t *testing.T) {
+	validator := NewRustRegexExtractorValidator()
+
+	assert.True(t, IsValidForValidator(types.StringValue(`step=(\w+)`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`(?:took|duration)=(?P<ms>\d+)ms`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`step=\w+`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`(\w+)=(\d+)`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`(?<=step=)(\w+)`), validator))
+
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+}
+
+func TestRustRegexParserValidator(t *testing.T) {
+	validator := NewRustRegexParserValidator()
+
+	assert.True(t, IsValidForValidator(types.StringValue(`(?P<status>\d{3}) (?P<bytes>\d+)`), validator))
+	assert.True(t, IsValidForValidator(types.StringValue(`user=(?<user>\w+)`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`user=(\w+)`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`\(?P<user>\w+\)`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`[(?P<user>)]+`), validator))
+	assert.False(t, IsValidForValidator(types.StringValue(`(?P<user>`), validator))
+
+	assert.True(t, IsValidForValidator(types.StringNull(), validator))
+}
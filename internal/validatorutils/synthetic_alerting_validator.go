@@ -0,0 +1,60 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// syntheticAlertingValidator validates, at plan time, that a synthetic
+// monitor's alerting does not require more locations to fail than the monitor
+// runs from, as the alert could then never fire.
+type syntheticAlertingValidator struct{}
+
+var _ resource.ConfigValidator = (*syntheticAlertingValidator)(nil)
+
+func NewSyntheticAlertingValidator() resource.ConfigValidator {
+	return &syntheticAlertingValidator{}
+}
+
+func (v syntheticAlertingValidator) Description(ctx context.Context) string {
+	return "Validates that failed_locations does not exceed the number of locations."
+}
+
+func (v syntheticAlertingValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v syntheticAlertingValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	alertingPath := path.Root("alerting")
+
+	var failedLocations types.Int64
+	var locations types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, alertingPath.AtName("failed_locations"), &failedLocations)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("locations"), &locations)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without locations, the monitor runs from the locations picked by Oodle,
+	// whose number is not known at plan time.
+	if failedLocations.IsNull() || failedLocations.IsUnknown() ||
+		locations.IsNull() || locations.IsUnknown() || len(locations.Elements()) == 0 {
+		return
+	}
+	if n, m := failedLocations.ValueInt64(), len(locations.Elements()); n > int64(m) {
+		resp.Diagnostics.AddAttributeError(
+			alertingPath.AtName("failed_locations"),
+			"Invalid alerting threshold",
+			fmt.Sprintf("failed_locations is %d, but the monitor only runs from %d locations, so the alert could "+
+				"never fire.", n, m),
+		)
+	}
+}
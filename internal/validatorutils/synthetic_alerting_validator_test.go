@@ -0,0 +1,100 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestSyntheticAlertingValidator(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"locations": schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"alerting": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"failed_locations":     schema.Int64Attribute{Optional: true},
+					"consecutive_failures": schema.Int64Attribute{Optional: true},
+				},
+			},
+		},
+	}
+	locationsType := tftypes.List{ElementType: tftypes.String}
+	alertingType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"failed_locations":     tftypes.Number,
+		"consecutive_failures": tftypes.Number,
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"locations": locationsType,
+		"alerting":  alertingType,
+	}}
+
+	tests := []struct {
+		name                string
+		locations           []string
+		failedLocations     any
+		consecutiveFailures any
+		wantErrors          []string
+	}{
+		{name: "defaults"},
+		{name: "2 of 3", locations: []string{"a", "b", "c"}, failedLocations: 2, consecutiveFailures: 3},
+		{name: "all locations", locations: []string{"a", "b"}, failedLocations: 2},
+		{name: "default locations", failedLocations: 3},
+		{name: "unknown", locations: []string{"a"}, failedLocations: tftypes.UnknownValue},
+		{name: "thresholds left to the schema", failedLocations: 0, consecutiveFailures: -1},
+		{
+			name:            "more than locations",
+			locations:       []string{"a", "b"},
+			failedLocations: 3,
+			wantErrors: []string{
+				"failed_locations is 3, but the monitor only runs from 2 locations, so the alert could never fire.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locations := tftypes.NewValue(locationsType, nil)
+			if tt.locations != nil {
+				values := make([]tftypes.Value, len(tt.locations))
+				for i, l := range tt.locations {
+					values[i] = tftypes.NewValue(tftypes.String, l)
+				}
+				locations = tftypes.NewValue(locationsType, values)
+			}
+			number := func(v any) tftypes.Value {
+				if i, ok := v.(int); ok {
+					v = int64(i)
+				}
+				return tftypes.NewValue(tftypes.Number, v)
+			}
+			raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"locations": locations,
+				"alerting": tftypes.NewValue(alertingType, map[string]tftypes.Value{
+					"failed_locations":     number(tt.failedLocations),
+					"consecutive_failures": number(tt.consecutiveFailures),
+				}),
+			})
+
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: raw},
+			}
+			resp := &resource.ValidateConfigResponse{}
+			NewSyntheticAlertingValidator().ValidateResource(ctx, req, resp)
+
+			var errs []string
+			for _, d := range resp.Diagnostics.Errors() {
+				errs = append(errs, d.Detail())
+			}
+			assert.DeepEqual(t, errs, tt.wantErrors)
+		})
+	}
+}
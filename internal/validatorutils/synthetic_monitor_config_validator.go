@@ -3,6 +3,7 @@ package validatorutils
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,8 +22,16 @@ var _ resource.ConfigValidator = (*syntheticMonitorConfigValidator)(nil)
 var ruleTypeToConfigAttr = map[string]string{
 	"http":      "http",
 	"multistep": "multistep",
+	"tcp":       "tcp",
+	"dns":       "dns",
+	"ssl":       "ssl",
+	"grpc":      "grpc",
 }
 
+// ruleConfigAttrs lists the rule_config attributes in the order they are
+// mentioned in diagnostics.
+var ruleConfigAttrs = []string{"http", "multistep", "tcp", "dns", "ssl", "grpc"}
+
 func NewSyntheticMonitorConfigValidator() resource.ConfigValidator {
 	return &syntheticMonitorConfigValidator{}
 }
@@ -41,36 +50,36 @@ func (v syntheticMonitorConfigValidator) ValidateResource(
 	resp *resource.ValidateConfigResponse,
 ) {
 	var ruleType types.String
-	var httpConfig types.Object
-	var multistepConfig types.Object
-
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx,
 		path.Root("rule_type"), &ruleType)...)
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx,
-		path.Root("rule_config").AtName("http"), &httpConfig)...)
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx,
-		path.Root("rule_config").AtName("multistep"), &multistepConfig)...)
+
+	var setAttrs []string
+	for _, attr := range ruleConfigAttrs {
+		var config types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx,
+			path.Root("rule_config").AtName(attr), &config)...)
+		if !config.IsNull() && !config.IsUnknown() {
+			setAttrs = append(setAttrs, attr)
+		}
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	httpSet := !httpConfig.IsNull() && !httpConfig.IsUnknown()
-	multistepSet := !multistepConfig.IsNull() && !multistepConfig.IsUnknown()
-
 	// Exactly one rule_config block must be set.
 	switch {
-	case !httpSet && !multistepSet:
+	case len(setAttrs) == 0:
 		resp.Diagnostics.AddAttributeError(
 			path.Root("rule_config"),
 			"Missing rule configuration",
-			"Exactly one of rule_config.http or rule_config.multistep must be set.",
+			fmt.Sprintf("Exactly one of %s must be set.", ruleConfigList(ruleConfigAttrs)),
 		)
 		return
-	case httpSet && multistepSet:
+	case len(setAttrs) > 1:
 		resp.Diagnostics.AddAttributeError(
 			path.Root("rule_config"),
 			"Conflicting rule configuration",
-			"Only one of rule_config.http or rule_config.multistep may be set.",
+			fmt.Sprintf("Only one of %s may be set.", ruleConfigList(setAttrs)),
 		)
 		return
 	}
@@ -86,8 +95,7 @@ func (v syntheticMonitorConfigValidator) ValidateResource(
 	if !recognized {
 		return
 	}
-	if (expectedAttr == "http" && !httpSet) ||
-		(expectedAttr == "multistep" && !multistepSet) {
+	if setAttrs[0] != expectedAttr {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("rule_config"),
 			"Mismatched rule configuration",
@@ -98,3 +106,16 @@ func (v syntheticMonitorConfigValidator) ValidateResource(
 		)
 	}
 }
+
+// ruleConfigList formats attribute names as "rule_config.a, rule_config.b or
+// rule_config.c".
+func ruleConfigList(attrs []string) string {
+	names := make([]string, len(attrs))
+	for i, attr := range attrs {
+		names[i] = "rule_config." + attr
+	}
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
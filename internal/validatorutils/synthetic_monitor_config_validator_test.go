@@ -0,0 +1,104 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+func TestSyntheticMonitorConfigValidator(t *testing.T) {
+	ctx := context.Background()
+
+	// Each rule_config block is reduced to a single attribute, which is all
+	// the validator looks at.
+	blockAttrs := map[string]schema.Attribute{}
+	blockTypes := map[string]tftypes.Type{}
+	blockType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"x": tftypes.String}}
+	for _, attr := range ruleConfigAttrs {
+		blockAttrs[attr] = schema.SingleNestedAttribute{
+			Optional:   true,
+			Attributes: map[string]schema.Attribute{"x": schema.StringAttribute{Optional: true}},
+		}
+		blockTypes[attr] = blockType
+	}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rule_type":   schema.StringAttribute{Required: true},
+			"rule_config": schema.SingleNestedAttribute{Required: true, Attributes: blockAttrs},
+		},
+	}
+	ruleConfigType := tftypes.Object{AttributeTypes: blockTypes}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"rule_type":   tftypes.String,
+		"rule_config": ruleConfigType,
+	}}
+
+	tests := []struct {
+		name      string
+		ruleType  any
+		set       []string
+		wantError string
+	}{
+		{name: "http", ruleType: "http", set: []string{"http"}},
+		{name: "multistep", ruleType: "multistep", set: []string{"multistep"}},
+		{name: "tcp", ruleType: "tcp", set: []string{"tcp"}},
+		{name: "dns", ruleType: "dns", set: []string{"dns"}},
+		{name: "ssl", ruleType: "ssl", set: []string{"ssl"}},
+		{name: "grpc", ruleType: "grpc", set: []string{"grpc"}},
+		{name: "unknown rule_type", ruleType: tftypes.UnknownValue, set: []string{"dns"}},
+		{
+			name:      "none set",
+			ruleType:  "tcp",
+			wantError: "Exactly one of rule_config.http, rule_config.multistep, rule_config.tcp, rule_config.dns, rule_config.ssl or rule_config.grpc must be set.",
+		},
+		{
+			name:      "two set",
+			ruleType:  "ssl",
+			set:       []string{"tcp", "ssl"},
+			wantError: "Only one of rule_config.tcp or rule_config.ssl may be set.",
+		},
+		{
+			name:      "mismatch",
+			ruleType:  "dns",
+			set:       []string{"tcp"},
+			wantError: `rule_type is "dns" but rule_config.dns is not set.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks := map[string]tftypes.Value{}
+			for _, attr := range ruleConfigAttrs {
+				blocks[attr] = tftypes.NewValue(blockType, nil)
+			}
+			for _, attr := range tt.set {
+				blocks[attr] = tftypes.NewValue(blockType, map[string]tftypes.Value{
+					"x": tftypes.NewValue(tftypes.String, "value"),
+				})
+			}
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: testSchema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"rule_type":   tftypes.NewValue(tftypes.String, tt.ruleType),
+						"rule_config": tftypes.NewValue(ruleConfigType, blocks),
+					}),
+				},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			NewSyntheticMonitorConfigValidator().ValidateResource(ctx, req, resp)
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.Equal(t, resp.Diagnostics.ErrorsCount(), 1)
+			assert.Equal(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantError)
+		})
+	}
+}
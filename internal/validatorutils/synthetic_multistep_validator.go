@@ -0,0 +1,309 @@
+package validatorutils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-oodle/internal/syntheticvars"
+)
+
+// syntheticMultistepValidator validates, at plan time, the variables of a
+// multi-step synthetic monitor: extract rules must have valid, unique names and
+// queries that parse, and {{VAR_NAME}} placeholders in a step's request must
+// not reference a variable before the step that extracts it has run. Names
+// that no step extracts reference global variables, whose existence is checked
+// against the API when planning. Without this a typo in a variable name is
+// only noticed when the monitor starts failing.
+type syntheticMultistepValidator struct{}
+
+var _ resource.ConfigValidator = (*syntheticMultistepValidator)(nil)
+
+var (
+	jsonPathIndexes = regexp.MustCompile(`^-?\d+(\s*,\s*-?\d+)*$`)
+	jsonPathSlice   = regexp.MustCompile(`^(-?\d+)?:(-?\d+)?(:-?\d+)?$`)
+)
+
+func NewSyntheticMultistepValidator() resource.ConfigValidator {
+	return &syntheticMultistepValidator{}
+}
+
+func (v syntheticMultistepValidator) Description(ctx context.Context) string {
+	return "Validates that multi-step variables are well formed and only referenced after the step that extracts them."
+}
+
+func (v syntheticMultistepValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v syntheticMultistepValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	stepsPath := path.Root("rule_config").AtName("multistep").AtName("steps")
+
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, stepsPath, &steps)...)
+	if resp.Diagnostics.HasError() || steps.IsNull() || steps.IsUnknown() {
+		return
+	}
+
+	// definedBy maps each variable name to the index of the step that it is
+	// extracted by. References are only checked when all the names are
+	// known, so that a name interpolated from another resource does not cause
+	// spurious errors.
+	definedBy := map[string]int{}
+	allKnown := true
+	for i, step := range steps.Elements() {
+		if step.IsUnknown() {
+			allKnown = false
+			continue
+		}
+		extract, ok := objectAttribute(step, "extract").(types.List)
+		if !ok || extract.IsNull() {
+			continue
+		}
+		if extract.IsUnknown() {
+			allKnown = false
+			continue
+		}
+		for j, rule := range extract.Elements() {
+			rulePath := stepsPath.AtListIndex(i).AtName("extract").AtListIndex(j)
+			name, ok := knownString(objectAttribute(rule, "name"))
+			if !ok {
+				allKnown = false
+			} else {
+				if !syntheticvars.ValidName(name) {
+					resp.Diagnostics.AddAttributeError(
+						rulePath.AtName("name"),
+						"Invalid variable name",
+						fmt.Sprintf("Variable name %q must be uppercase, start with a letter, and be at least 3 characters "+
+							"(e.g., 'ACCESS_TOKEN').", name),
+					)
+				} else if prev, exists := definedBy[name]; exists {
+					resp.Diagnostics.AddAttributeError(
+						rulePath.AtName("name"),
+						"Duplicate variable name",
+						fmt.Sprintf("Variable %q is already extracted by step %d.", name, prev+1),
+					)
+				} else {
+					definedBy[name] = i
+				}
+			}
+
+			parser, parserKnown := knownString(objectAttribute(rule, "parser"))
+			query, queryKnown := knownString(objectAttribute(rule, "query"))
+			if !parserKnown || !queryKnown {
+				continue
+			}
+			if err := checkExtractQuery(parser, query); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName("query"),
+					"Invalid extract query",
+					err.Error(),
+				)
+			}
+		}
+	}
+	if !allKnown {
+		return
+	}
+
+	for i, step := range steps.Elements() {
+		requestPath := stepsPath.AtListIndex(i).AtName("request")
+		for _, template := range requestTemplates(requestPath, objectAttribute(step, "request")) {
+			for _, name := range syntheticvars.References(template.value) {
+				checkReference(resp, template.path, name, i, definedBy)
+			}
+		}
+	}
+}
+
+// checkReference reports a placeholder in step that is not a valid reference,
+// or that references a variable extracted by the same or a later step.
+func checkReference(resp *resource.ValidateConfigResponse, p path.Path, name string, step int, definedBy map[string]int) {
+	if !syntheticvars.ValidName(name) {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Invalid variable reference",
+			fmt.Sprintf("{{%s}} is not a valid variable reference. Variable names must be uppercase, start with a "+
+				"letter, and be at least 3 characters.", name),
+		)
+		return
+	}
+
+	// Names that no step extracts reference global variables.
+	if definedAt, ok := definedBy[name]; ok && definedAt >= step {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			"Variable used before it is extracted",
+			fmt.Sprintf("{{%s}} is extracted by step %d, so it can only be used from step %d on.",
+				name, definedAt+1, definedAt+2),
+		)
+	}
+}
+
+// requestTemplate is a request attribute value that may hold placeholders.
+type requestTemplate struct {
+	path  path.Path
+	value string
+}
+
+// requestTemplates returns the known values of the request attributes that
+// may hold placeholders.
+func requestTemplates(requestPath path.Path, request attr.Value) []requestTemplate {
+	var templates []requestTemplate
+	add := func(p path.Path, value attr.Value) {
+		if s, ok := knownString(value); ok {
+			templates = append(templates, requestTemplate{path: p, value: s})
+		}
+	}
+
+	for _, name := range []string{"url", "body", "bearer_token"} {
+		add(requestPath.AtName(name), objectAttribute(request, name))
+	}
+	if headers, ok := objectAttribute(request, "headers").(types.Map); ok {
+		elements := headers.Elements()
+		keys := make([]string, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			add(requestPath.AtName("headers").AtMapKey(key), elements[key])
+		}
+	}
+	basicAuth := objectAttribute(request, "basic_auth")
+	for _, name := range []string{"username", "password"} {
+		add(requestPath.AtName("basic_auth").AtName(name), objectAttribute(basicAuth, name))
+	}
+	return templates
+}
+
+// objectAttribute returns the named attribute of an object value, or nil if
+// the value is not a known object.
+func objectAttribute(value attr.Value, name string) attr.Value {
+	obj, ok := value.(types.Object)
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	return obj.Attributes()[name]
+}
+
+// knownString returns the value of a known string.
+func knownString(value attr.Value) (string, bool) {
+	s, ok := value.(types.String)
+	if !ok || s.IsNull() || s.IsUnknown() {
+		return "", false
+	}
+	return s.ValueString(), true
+}
+
+// checkExtractQuery checks that the query of an extract rule parses with its
+// parser.
+func checkExtractQuery(parser, query string) error {
+	switch parser {
+	case "jsonpath":
+		return checkJSONPath(query)
+	case "regex":
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return fmt.Errorf("invalid regex %q: %v", query, err)
+		}
+		if re.NumSubexp() == 0 {
+			return fmt.Errorf("regex %q must have a capture group holding the extracted value", query)
+		}
+	}
+	return nil
+}
+
+// checkJSONPath checks the syntax of a JSONPath expression: a leading "$"
+// followed by ".key", "..key", ".*" and bracketed subscripts such as "[0]",
+// "['key']", "[*]", "[1:3]" or "[?(@.active)]".
+func checkJSONPath(query string) error {
+	if !strings.HasPrefix(query, "$") {
+		return fmt.Errorf("invalid JSONPath %q: must start with '$'", query)
+	}
+
+	rest := query[1:]
+	for len(rest) > 0 {
+		switch {
+		case rest[0] == '.':
+			rest = strings.TrimPrefix(rest[1:], ".")
+			if strings.HasPrefix(rest, "[") {
+				continue
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return fmt.Errorf("invalid JSONPath %q: empty key", query)
+			}
+			rest = rest[end:]
+		case rest[0] == '[':
+			end, err := subscriptEnd(rest)
+			if err != nil {
+				return fmt.Errorf("invalid JSONPath %q: %v", query, err)
+			}
+			inner := strings.TrimSpace(rest[1:end])
+			if !validSubscript(inner) {
+				return fmt.Errorf("invalid JSONPath %q: unsupported subscript [%v]", query, inner)
+			}
+			rest = rest[end+1:]
+		default:
+			return fmt.Errorf("invalid JSONPath %q: unexpected %q", query, rest[0])
+		}
+	}
+	return nil
+}
+
+// subscriptEnd returns the index of the ']' closing the subscript that s
+// starts with, skipping over quoted strings and nested brackets.
+func subscriptEnd(s string) (int, error) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'', '"':
+			quote := s[i]
+			for i++; i < len(s) && s[i] != quote; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return 0, fmt.Errorf("unclosed string")
+			}
+		case '[', '(':
+			depth++
+		case ')':
+			depth--
+		case ']':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unclosed '['")
+}
+
+func validSubscript(inner string) bool {
+	switch {
+	case inner == "*":
+		return true
+	case strings.HasPrefix(inner, "?(") || strings.HasPrefix(inner, "("):
+		return strings.HasSuffix(inner, ")")
+	case strings.HasPrefix(inner, "'") || strings.HasPrefix(inner, `"`):
+		return true
+	}
+	return jsonPathIndexes.MatchString(inner) || jsonPathSlice.MatchString(inner)
+}
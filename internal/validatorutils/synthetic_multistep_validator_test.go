@@ -0,0 +1,235 @@
+package validatorutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/testwell/assert"
+)
+
+// The test config only holds the attributes that the validator reads.
+type testMultistepConfig struct {
+	RuleConfig struct {
+		Multistep *struct {
+			Steps []testStep `tfsdk:"steps"`
+		} `tfsdk:"multistep"`
+	} `tfsdk:"rule_config"`
+}
+
+type testStep struct {
+	Request struct {
+		URL         types.String      `tfsdk:"url"`
+		Body        types.String      `tfsdk:"body"`
+		BearerToken types.String      `tfsdk:"bearer_token"`
+		Headers     map[string]string `tfsdk:"headers"`
+	} `tfsdk:"request"`
+	Extract []testExtractRule `tfsdk:"extract"`
+}
+
+type testExtractRule struct {
+	Name   types.String `tfsdk:"name"`
+	Parser types.String `tfsdk:"parser"`
+	Query  types.String `tfsdk:"query"`
+}
+
+var testMultistepSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"rule_config": schema.SingleNestedAttribute{
+			Required: true,
+			Attributes: map[string]schema.Attribute{
+				"multistep": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"steps": schema.ListNestedAttribute{
+							Required: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"request": schema.SingleNestedAttribute{
+										Required: true,
+										Attributes: map[string]schema.Attribute{
+											"url":          schema.StringAttribute{Required: true},
+											"body":         schema.StringAttribute{Optional: true},
+											"bearer_token": schema.StringAttribute{Optional: true},
+											"headers":      schema.MapAttribute{Optional: true, ElementType: types.StringType},
+										},
+									},
+									"extract": schema.ListNestedAttribute{
+										Optional: true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"name":   schema.StringAttribute{Required: true},
+												"parser": schema.StringAttribute{Required: true},
+												"query":  schema.StringAttribute{Required: true},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func newTestStep(url string, extract ...testExtractRule) testStep {
+	var s testStep
+	s.Request.URL = types.StringValue(url)
+	s.Extract = extract
+	return s
+}
+
+func newTestExtractRule(name, parser, query string) testExtractRule {
+	return testExtractRule{
+		Name:   types.StringValue(name),
+		Parser: types.StringValue(parser),
+		Query:  types.StringValue(query),
+	}
+}
+
+func validateMultistep(t *testing.T, steps ...testStep) []string {
+	ctx := context.Background()
+	var config testMultistepConfig
+	config.RuleConfig.Multistep = &struct {
+		Steps []testStep `tfsdk:"steps"`
+	}{Steps: steps}
+
+	plan := tfsdk.Plan{
+		Schema: testMultistepSchema,
+		Raw:    tftypes.NewValue(testMultistepSchema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, &config)
+	assert.False(t, diags.HasError())
+
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: testMultistepSchema, Raw: plan.Raw},
+	}
+	resp := &resource.ValidateConfigResponse{}
+	NewSyntheticMultistepValidator().ValidateResource(ctx, req, resp)
+
+	var errs []string
+	for _, d := range resp.Diagnostics.Errors() {
+		errs = append(errs, d.Detail())
+	}
+	return errs
+}
+
+func TestSyntheticMultistepValidator(t *testing.T) {
+	login := newTestStep("https://api.example.com/login",
+		newTestExtractRule("ACCESS_TOKEN", "jsonpath", "$.access_token"),
+		newTestExtractRule("USER_ID", "regex", `"id":"(\w+)"`),
+	)
+	profile := newTestStep("https://api.example.com/users/{{USER_ID}}")
+	profile.Request.BearerToken = types.StringValue("{{ACCESS_TOKEN}}")
+	profile.Request.Headers = map[string]string{"X-User": "{{USER_ID}}"}
+
+	assert.Equal(t, len(validateMultistep(t, login, profile)), 0)
+
+	t.Run("global variable", func(t *testing.T) {
+		s := newTestStep("https://{{API_HOST}}/users/{{USER_ID}}")
+		assert.Equal(t, len(validateMultistep(t, login, s)), 0)
+	})
+
+	t.Run("later-defined variable", func(t *testing.T) {
+		assert.DeepEqual(t, validateMultistep(t, profile, login), []string{
+			"{{USER_ID}} is extracted by step 2, so it can only be used from step 3 on.",
+			"{{ACCESS_TOKEN}} is extracted by step 2, so it can only be used from step 3 on.",
+			"{{USER_ID}} is extracted by step 2, so it can only be used from step 3 on.",
+		})
+	})
+
+	t.Run("variable from the same step", func(t *testing.T) {
+		s := newTestStep("https://api.example.com/{{NEXT_PAGE}}", newTestExtractRule("NEXT_PAGE", "jsonpath", "$.next"))
+		assert.DeepEqual(t, validateMultistep(t, s), []string{
+			"{{NEXT_PAGE}} is extracted by step 1, so it can only be used from step 2 on.",
+		})
+	})
+
+	t.Run("invalid reference", func(t *testing.T) {
+		s := newTestStep("https://api.example.com/{{ user_id }}")
+		assert.DeepEqual(t, validateMultistep(t, login, s), []string{
+			"{{ user_id }} is not a valid variable reference. Variable names must be uppercase, start with a " +
+				"letter, and be at least 3 characters.",
+		})
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		s := newTestStep("https://api.example.com/refresh", newTestExtractRule("ACCESS_TOKEN", "jsonpath", "$.token"))
+		assert.DeepEqual(t, validateMultistep(t, login, s), []string{
+			`Variable "ACCESS_TOKEN" is already extracted by step 1.`,
+		})
+	})
+
+	t.Run("invalid names", func(t *testing.T) {
+		s := newTestStep("https://api.example.com/login",
+			newTestExtractRule("token", "jsonpath", "$.token"),
+			newTestExtractRule("ID", "jsonpath", "$.id"),
+			newTestExtractRule("1ST_ID", "jsonpath", "$.id"),
+		)
+		errs := validateMultistep(t, s)
+		assert.Equal(t, len(errs), 3)
+		assert.Equal(t, errs[0], `Variable name "token" must be uppercase, start with a letter, and be at least 3 `+
+			`characters (e.g., 'ACCESS_TOKEN').`)
+	})
+
+	t.Run("unknown name skips references", func(t *testing.T) {
+		s := newTestStep("https://api.example.com/login", newTestExtractRule("ACCESS_TOKEN", "jsonpath", "$.token"))
+		s.Extract[0].Name = types.StringUnknown()
+		assert.Equal(t, len(validateMultistep(t, s, profile)), 0)
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		s := newTestStep("https://api.example.com/login",
+			newTestExtractRule("TOKEN_A", "jsonpath", "access_token"),
+			newTestExtractRule("TOKEN_B", "jsonpath", "$.items[0"),
+			newTestExtractRule("TOKEN_C", "regex", `token=(\w+`),
+			newTestExtractRule("TOKEN_D", "regex", `token=\w+`),
+		)
+		assert.DeepEqual(t, validateMultistep(t, s), []string{
+			`invalid JSONPath "access_token": must start with '$'`,
+			`invalid JSONPath "$.items[0": unclosed '['`,
+			"invalid regex \"token=(\\\\w+\": error parsing regexp: missing closing ): `token=(\\w+`",
+			`regex "token=\\w+" must have a capture group holding the extracted value`,
+		})
+	})
+}
+
+func TestCheckJSONPath(t *testing.T) {
+	for _, query := range []string{
+		"$",
+		"$.access_token",
+		"$.data.items[0].id",
+		"$['user']['first name']",
+		"$..id",
+		"$.items[*].id",
+		"$.items[-1]",
+		"$.items[0,2]",
+		"$.items[1:3]",
+		"$.items[?(@.active == true)].id",
+		"$.items[?(@.name == 'a]b')]",
+	} {
+		assert.Nil(t, checkJSONPath(query))
+	}
+
+	for query, wantErr := range map[string]string{
+		"data.id":      `invalid JSONPath "data.id": must start with '$'`,
+		"$.":           `invalid JSONPath "$.": empty key`,
+		"$.items[":     `invalid JSONPath "$.items[": unclosed '['`,
+		"$['id]":       `invalid JSONPath "$['id]": unclosed string`,
+		"$.items[]":    `invalid JSONPath "$.items[]": unsupported subscript []`,
+		"$.items[foo]": `invalid JSONPath "$.items[foo]": unsupported subscript [foo]`,
+		"$x":           `invalid JSONPath "$x": unexpected 'x'`,
+	} {
+		err := checkJSONPath(query)
+		if err == nil {
+			t.Fatalf("expected error for %q", query)
+		}
+		assert.Equal(t, err.Error(), wantErr)
+	}
+}